@@ -3,10 +3,11 @@ module github.com/terraform-provider-openstack/terraform-provider-openstack
 go 1.14
 
 require (
-	github.com/gophercloud/gophercloud v0.15.0
+	github.com/gophercloud/gophercloud v0.24.0
 	github.com/gophercloud/utils v0.0.0-20210113034859-6f548432055a
 	github.com/hashicorp/terraform-plugin-sdk v1.16.0
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/stretchr/testify v1.4.0
-	gopkg.in/yaml.v2 v2.3.0
+	golang.org/x/crypto v0.0.0-20211202192323-5770296d904e
+	gopkg.in/yaml.v2 v2.4.0
 )
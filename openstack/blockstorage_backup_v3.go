@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	// blockStorageBackupV3UpdateMicroversion is the minimum microversion
+	// which allows to update a backup.
+	blockStorageBackupV3UpdateMicroversion = "3.9"
+
+	// blockStorageBackupV3MetadataMicroversion is the minimum microversion
+	// which allows to set and read the metadata of a backup.
+	blockStorageBackupV3MetadataMicroversion = "3.43"
+)
+
+func blockStorageBackupV3StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := backups.Get(client, backupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if b.Status == "error" {
+			return b, b.Status, fmt.Errorf("The backup is in error status: %s. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", b.FailReason)
+		}
+
+		return b, b.Status, nil
+	}
+}
+
+func flattenBlockStorageBackupV3Metadata(b *backups.Backup) map[string]string {
+	if b.Metadata == nil {
+		return map[string]string{}
+	}
+
+	return *b.Metadata
+}
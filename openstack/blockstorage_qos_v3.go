@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/qos"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+func expandBlockStorageQoSV3Specs(raw map[string]interface{}) map[string]string {
+	specs := make(map[string]string, len(raw))
+	for k, v := range raw {
+		specs[k] = v.(string)
+	}
+
+	return specs
+}
+
+func parseBlockStorageQoSAssociationID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine QoS association ID")
+	}
+
+	qosID := idParts[0]
+	volumeTypeID := idParts[1]
+
+	return qosID, volumeTypeID, nil
+}
+
+func getBlockStorageQoSAssociation(client *gophercloud.ServiceClient, qosID, volumeTypeID string) (qos.QosAssociation, error) {
+	var association qos.QosAssociation
+
+	found := false
+	pager := qos.ListAssociations(client, qosID)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		associations, err := qos.ExtractAssociations(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, a := range associations {
+			if a.ID == volumeTypeID {
+				association = a
+				found = true
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return association, err
+	}
+
+	if !found {
+		return association, gophercloud.ErrDefault404{}
+	}
+
+	return association, nil
+}
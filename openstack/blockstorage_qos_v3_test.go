@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandBlockStorageQoSV3Specs(t *testing.T) {
+	raw := map[string]interface{}{
+		"read_iops_sec":  "20000",
+		"write_iops_sec": "10000",
+	}
+
+	expected := map[string]string{
+		"read_iops_sec":  "20000",
+		"write_iops_sec": "10000",
+	}
+
+	actual := expandBlockStorageQoSV3Specs(raw)
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseBlockStorageQoSAssociationID(t *testing.T) {
+	id := "d32019d3-bc6e-4319-9c1d-6722fc136a22/38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e"
+
+	qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", qosID)
+	assert.Equal(t, "38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e", volumeTypeID)
+
+	_, _, err = parseBlockStorageQoSAssociationID("d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	assert.Error(t, err)
+}
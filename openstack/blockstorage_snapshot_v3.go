@@ -1,9 +1,14 @@
 package openstack
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
 // blockStorageV3SnapshotSort represents a sortable slice of block storage
@@ -29,3 +34,51 @@ func dataSourceBlockStorageV3MostRecentSnapshot(snapshots []snapshots.Snapshot)
 	sort.Sort(blockStorageV3SnapshotSort(sortedSnapshots))
 	return sortedSnapshots[len(sortedSnapshots)-1]
 }
+
+func blockStorageSnapshotV3StateRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := snapshots.Get(client, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The snapshot is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// blockStorageSnapshotV3DependentVolumes returns the IDs of the volumes
+// which were created from the given snapshot and still exist.
+func blockStorageSnapshotV3DependentVolumes(client *gophercloud.ServiceClient, snapshotID string) ([]string, error) {
+	allPages, err := volumes.List(client, volumes.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list volumes: %s", err)
+	}
+
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to extract volumes: %s", err)
+	}
+
+	return filterBlockStorageSnapshotV3DependentVolumes(allVolumes, snapshotID), nil
+}
+
+func filterBlockStorageSnapshotV3DependentVolumes(allVolumes []volumes.Volume, snapshotID string) []string {
+	var volumeIDs []string
+	for _, v := range allVolumes {
+		if v.SnapshotID == snapshotID && v.Status != "deleting" {
+			volumeIDs = append(volumeIDs, v.ID)
+		}
+	}
+
+	return volumeIDs
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterBlockStorageSnapshotV3DependentVolumes(t *testing.T) {
+	allVolumes := []volumes.Volume{
+		{
+			ID:         "289da7f8-6440-407c-9fb4-7db01ec49164",
+			SnapshotID: "2bb856e1-b3d8-4432-a858-09e4ce939389",
+			Status:     "available",
+		},
+		{
+			ID:         "96c3bda7-c82a-4f50-be73-ca7621794835",
+			SnapshotID: "",
+			Status:     "available",
+		},
+		{
+			ID:         "d6cacb1a-8b59-4c88-ad90-d70ebb82bb75",
+			SnapshotID: "2bb856e1-b3d8-4432-a858-09e4ce939389",
+			Status:     "deleting",
+		},
+		{
+			ID:         "f8bbd9ae-c8d8-4b0c-bb0f-4b12d68d0fe3",
+			SnapshotID: "2bb856e1-b3d8-4432-a858-09e4ce939389",
+			Status:     "in-use",
+		},
+	}
+
+	expected := []string{
+		"289da7f8-6440-407c-9fb4-7db01ec49164",
+		"f8bbd9ae-c8d8-4b0c-bb0f-4b12d68d0fe3",
+	}
+
+	actual := filterBlockStorageSnapshotV3DependentVolumes(allVolumes, "2bb856e1-b3d8-4432-a858-09e4ce939389")
+	assert.Equal(t, expected, actual)
+
+	actual = filterBlockStorageSnapshotV3DependentVolumes(allVolumes, "4a5d6f1e-5d0c-4bd4-8f4a-2c4c1d8a2e1a")
+	assert.Empty(t, actual)
+}
@@ -4,9 +4,46 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/attachments"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	// blockStorageV3AttachmentsMicroversion is the minimum microversion
+	// which allows to create and complete attachments via the attachments API.
+	blockStorageV3AttachmentsMicroversion = "3.44"
+
+	// blockStorageV3AttachmentsModeMicroversion is the minimum microversion
+	// which allows to set an attach mode via the attachments API.
+	blockStorageV3AttachmentsModeMicroversion = "3.54"
 )
 
+// blockStorageVolumeAttachV3AttachmentCreateOpts represents the attributes
+// used when creating a new attachment. The instance UUID is omitted when
+// it's not set, since it's optional for attachments not managed by Nova.
+type blockStorageVolumeAttachV3AttachmentCreateOpts struct {
+	attachments.CreateOpts
+}
+
+// ToAttachmentCreateMap casts a CreateOpts struct to a map.
+func (opts blockStorageVolumeAttachV3AttachmentCreateOpts) ToAttachmentCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToAttachmentCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := b["attachment"].(map[string]interface{}); ok && opts.InstanceUUID == "" {
+		delete(v, "instance_uuid")
+	}
+
+	return b, nil
+}
+
 func expandBlockStorageV3AttachMode(v string) (volumeactions.AttachMode, error) {
 	var attachMode volumeactions.AttachMode
 	var attachError error
@@ -25,11 +62,123 @@ func expandBlockStorageV3AttachMode(v string) (volumeactions.AttachMode, error)
 	return attachMode, attachError
 }
 
+func expandBlockStorageVolumeAttachV3Connector(d *schema.ResourceData) map[string]interface{} {
+	connector := map[string]interface{}{
+		"host": d.Get("host_name").(string),
+	}
+
+	if v, ok := d.GetOk("multipath"); ok {
+		connector["multipath"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("ip_address"); ok {
+		connector["ip"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("initiator"); ok {
+		connector["initiator"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("os_type"); ok {
+		connector["os_type"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("platform"); ok {
+		connector["platform"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("wwnn"); ok {
+		connector["wwnns"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("wwpn"); ok {
+		connector["wwpns"] = expandToStringSlice(v.([]interface{}))
+	}
+
+	return connector
+}
+
+// flattenBlockStorageVolumeAttachV3ConnectionData returns the string values
+// of the connection information. The legacy API nests them under a "data"
+// key, while the attachments API may return them on the top level.
+func flattenBlockStorageVolumeAttachV3ConnectionData(connInfo map[string]interface{}) map[string]string {
+	raw := connInfo
+	if v, ok := connInfo["data"].(map[string]interface{}); ok {
+		raw = v
+	}
+
+	data := make(map[string]string)
+	for key, value := range raw {
+		if key == "driver_volume_type" || key == "mount_point_base" {
+			continue
+		}
+
+		if v, ok := value.(string); ok {
+			data[key] = v
+		}
+	}
+
+	return data
+}
+
 func blockStorageVolumeAttachV3ParseID(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
-	if len(parts) < 2 {
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return "", "", fmt.Errorf("Unable to determine openstack_blockstorage_volume_attach_v3 ID")
 	}
 
 	return parts[0], parts[1], nil
 }
+
+// blockStorageVolumeAttachV3AttachmentsMicroversion returns the microversion
+// which should be used to manage the attachment via the attachments API.
+// An empty string is returned, when the legacy volume actions must be used.
+func blockStorageVolumeAttachV3AttachmentsMicroversion(config *Config, client *gophercloud.ServiceClient, attachMode string) (string, error) {
+	maxMicroversion, err := blockStorageV3MaxMicroversion(config, client)
+	if err != nil {
+		return "", err
+	}
+
+	required := blockStorageV3AttachmentsMicroversion
+	if attachMode != "" {
+		required = blockStorageV3AttachmentsModeMicroversion
+	}
+
+	compatible, err := compatibleMicroversion("min", required, maxMicroversion)
+	if err != nil {
+		return "", err
+	}
+
+	if !compatible {
+		return "", nil
+	}
+
+	return required, nil
+}
+
+func blockStorageVolumeAttachV3AttachmentStateRefreshFunc(client *gophercloud.ServiceClient, volumeID, attachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := volumes.Get(client, volumeID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return v, "detached", nil
+			}
+
+			return nil, "", err
+		}
+
+		if v.Status == "error" {
+			return v, v.Status, fmt.Errorf("The volume is in error status. " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		for _, attachment := range v.Attachments {
+			if attachment.AttachmentID == attachmentID {
+				return v, "attached", nil
+			}
+		}
+
+		return v, "detached", nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/attachments"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,4 +27,63 @@ func TestBlockStorageVolumeAttachV3ParseID(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, expectedVolumeID, actualVolumeID)
 	assert.Equal(t, expectedAttachmentID, actualAttachmentID)
+
+	for _, id := range []string{"foo", "foo/bar/baz", "/bar", "foo/", "/"} {
+		_, _, err = blockStorageVolumeAttachV3ParseID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestFlattenBlockStorageVolumeAttachV3ConnectionData(t *testing.T) {
+	legacy := map[string]interface{}{
+		"driver_volume_type": "iscsi",
+		"data": map[string]interface{}{
+			"target_iqn":    "iqn.2010-10.org.openstack:volume-foo",
+			"target_lun":    float64(1),
+			"target_portal": "192.168.255.10:3260",
+		},
+	}
+
+	expected := map[string]string{
+		"target_iqn":    "iqn.2010-10.org.openstack:volume-foo",
+		"target_portal": "192.168.255.10:3260",
+	}
+
+	actual := flattenBlockStorageVolumeAttachV3ConnectionData(legacy)
+	assert.Equal(t, expected, actual)
+
+	attachment := map[string]interface{}{
+		"driver_volume_type": "iscsi",
+		"target_iqn":         "iqn.2010-10.org.openstack:volume-foo",
+		"target_portal":      "192.168.255.10:3260",
+	}
+
+	actual = flattenBlockStorageVolumeAttachV3ConnectionData(attachment)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageVolumeAttachV3AttachmentCreateOpts(t *testing.T) {
+	createOpts := blockStorageVolumeAttachV3AttachmentCreateOpts{
+		attachments.CreateOpts{
+			VolumeUUID: "foo",
+			Connector: map[string]interface{}{
+				"host": "devstack",
+			},
+			Mode: "ro",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"attachment": map[string]interface{}{
+			"volume_uuid": "foo",
+			"connector": map[string]interface{}{
+				"host": "devstack",
+			},
+			"mode": "ro",
+		},
+	}
+
+	actual, err := createOpts.ToAttachmentCreateMap()
+	assert.Equal(t, err, nil)
+	assert.Equal(t, expected, actual)
 }
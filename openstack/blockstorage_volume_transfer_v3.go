@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+)
+
+// blockStorageVolumeTransferV3Accepted reports whether the volume of a
+// transfer has left the current project. The volume is no longer visible
+// to the source project once the transfer is accepted, while an admin
+// still sees it with the target project as owner.
+func blockStorageVolumeTransferV3Accepted(client *gophercloud.ServiceClient, volumeID string) (bool, error) {
+	var volume struct {
+		TenantID string `json:"os-vol-tenant-attr:tenant_id"`
+	}
+	err := volumes.Get(client, volumeID).ExtractIntoStructPtr(&volume, "volume")
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if volume.TenantID == "" {
+		return false, nil
+	}
+
+	_, projectID, err := GetTokenInfo(client)
+	if err != nil {
+		return false, err
+	}
+
+	return volume.TenantID != projectID, nil
+}
@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageVolumeTransferV3Accepted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "token": {
+    "user": {
+      "id": "user_1"
+    },
+    "project": {
+      "id": "project_1"
+    }
+  }
+}`)
+	})
+
+	th.Mux.HandleFunc("/volumes/volume_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"volume": {"id": "volume_1", "status": "available"}}`)
+	})
+
+	th.Mux.HandleFunc("/volumes/volume_2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	th.Mux.HandleFunc("/volumes/volume_3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"volume": {"id": "volume_3", "status": "available", "os-vol-tenant-attr:tenant_id": "project_1"}}`)
+	})
+
+	th.Mux.HandleFunc("/volumes/volume_4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"volume": {"id": "volume_4", "status": "available", "os-vol-tenant-attr:tenant_id": "project_2"}}`)
+	})
+
+	client := thclient.ServiceClient()
+
+	expected := map[string]bool{
+		"volume_1": false,
+		"volume_2": true,
+		"volume_3": false,
+		"volume_4": true,
+	}
+
+	for volumeID, expectedAccepted := range expected {
+		accepted, err := blockStorageVolumeTransferV3Accepted(client, volumeID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccepted, accepted, volumeID)
+	}
+}
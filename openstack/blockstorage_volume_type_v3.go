@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+func expandBlockStorageVolumeTypeV3ExtraSpecs(raw map[string]interface{}) volumetypes.ExtraSpecsOpts {
+	extraSpecs := make(volumetypes.ExtraSpecsOpts, len(raw))
+	for k, v := range raw {
+		extraSpecs[k] = v.(string)
+	}
+
+	return extraSpecs
+}
+
+func parseBlockStorageVolumeTypeAccessID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine volume type access ID")
+	}
+
+	volumeTypeID := idParts[0]
+	projectID := idParts[1]
+
+	return volumeTypeID, projectID, nil
+}
+
+func getBlockStorageVolumeTypeAccess(client *gophercloud.ServiceClient, volumeTypeID, projectID string) (volumetypes.VolumeTypeAccess, error) {
+	var access volumetypes.VolumeTypeAccess
+
+	found := false
+	pager := volumetypes.ListAccesses(client, volumeTypeID)
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		accessList, err := volumetypes.ExtractAccesses(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, a := range accessList {
+			if a.VolumeTypeID == volumeTypeID && a.ProjectID == projectID {
+				access = a
+				found = true
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return access, err
+	}
+
+	if !found {
+		return access, gophercloud.ErrDefault404{}
+	}
+
+	return access, nil
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandBlockStorageVolumeTypeV3ExtraSpecs(t *testing.T) {
+	raw := map[string]interface{}{
+		"capabilities":        "gpu",
+		"volume_backend_name": "ssd",
+	}
+
+	expected := volumetypes.ExtraSpecsOpts{
+		"capabilities":        "gpu",
+		"volume_backend_name": "ssd",
+	}
+
+	actual := expandBlockStorageVolumeTypeV3ExtraSpecs(raw)
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseBlockStorageVolumeTypeAccessID(t *testing.T) {
+	id := "38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e/f5b0c6f2d1a74a6c8a4e2f1c0b9a8d7e"
+
+	volumeTypeID, projectID, err := parseBlockStorageVolumeTypeAccessID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e", volumeTypeID)
+	assert.Equal(t, "f5b0c6f2d1a74a6c8a4e2f1c0b9a8d7e", projectID)
+
+	_, _, err = parseBlockStorageVolumeTypeAccessID("38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e")
+	assert.Error(t, err)
+
+	_, _, err = parseBlockStorageVolumeTypeAccessID("38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e/f5b0c6f2d1a74a6c8a4e2f1c0b9a8d7e/extra")
+	assert.Error(t, err)
+
+	_, _, err = parseBlockStorageVolumeTypeAccessID("38a2e7a5-6b0b-4cd3-b0bd-6e4e6a7b0a2e/")
+	assert.Error(t, err)
+
+	_, _, err = parseBlockStorageVolumeTypeAccessID("/f5b0c6f2d1a74a6c8a4e2f1c0b9a8d7e")
+	assert.Error(t, err)
+}
@@ -3,14 +3,55 @@ package openstack
 import (
 	"bytes"
 	"fmt"
+	"log"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/apiversions"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// blockStorageVolumeV3BackupMicroversion is the minimum microversion which
+// allows to create a volume from a backup.
+const blockStorageVolumeV3BackupMicroversion = "3.47"
+
+// blockStorageV3MaxMicroversion returns the maximum microversion
+// supported by the Block Storage V3 API. The result is cached, so the
+// versions are only listed once per endpoint.
+func blockStorageV3MaxMicroversion(config *Config, client *gophercloud.ServiceClient) (string, error) {
+	if v, ok := config.maxMicroversions.Load(client.Endpoint); ok {
+		return v.(string), nil
+	}
+
+	allPages, err := apiversions.List(client).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	apiInfo, err := apiversions.ExtractAPIVersion(allPages, "v3.0")
+	if err != nil {
+		return "", err
+	}
+
+	config.maxMicroversions.Store(client.Endpoint, apiInfo.Version)
+
+	return apiInfo.Version, nil
+}
+
+// blockStorageV3MicroversionSupported checks whether the Block Storage V3 API
+// supports the given microversion.
+func blockStorageV3MicroversionSupported(config *Config, client *gophercloud.ServiceClient, required string) (bool, error) {
+	maxMicroversion, err := blockStorageV3MaxMicroversion(config, client)
+	if err != nil {
+		return false, err
+	}
+
+	return compatibleMicroversion("min", required, maxMicroversion)
+}
+
 func flattenBlockStorageVolumeV3Attachments(v []volumes.Attachment) []map[string]interface{} {
 	attachments := make([]map[string]interface{}, len(v))
 	for i, attachment := range v {
@@ -52,3 +93,14 @@ func blockStorageVolumeV3AttachmentHash(v interface{}) int {
 	}
 	return hashcode.String(buf.String())
 }
+
+func blockStorageVolumeV3VolumeTypeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" && diff.HasChange("volume_type") {
+		if diff.Get("migration_policy").(string) == "" {
+			log.Printf("[DEBUG] migration_policy is not set, volume_type change forces a new volume")
+			return diff.ForceNew("volume_type")
+		}
+	}
+
+	return nil
+}
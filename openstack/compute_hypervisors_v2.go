@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+)
+
+// computeHypervisorsV2HostnamePatternMicroversion is the minimum microversion
+// to filter the hypervisors list by hostname.
+const computeHypervisorsV2HostnamePatternMicroversion = "2.53"
+
+// computeHypervisorsV2Filter holds the client-side filters of
+// openstack_compute_hypervisors_v2. Empty filters match every hypervisor.
+type computeHypervisorsV2Filter struct {
+	State  string
+	Status string
+	Type   string
+
+	// HostnamePattern is only filtered client-side, when the Compute API
+	// doesn't support the hostname pattern microversion.
+	HostnamePattern string
+
+	// Hosts are the compute hosts of an aggregate. A nil map disables the
+	// aggregate filter.
+	Hosts map[string]bool
+}
+
+// match checks whether a hypervisor matches all filters.
+func (f computeHypervisorsV2Filter) match(h hypervisors.Hypervisor) bool {
+	if f.State != "" && !strings.EqualFold(f.State, h.State) {
+		return false
+	}
+
+	if f.Status != "" && !strings.EqualFold(f.Status, h.Status) {
+		return false
+	}
+
+	if f.Type != "" && !strings.EqualFold(f.Type, h.HypervisorType) {
+		return false
+	}
+
+	if f.HostnamePattern != "" &&
+		!strings.Contains(strings.ToLower(h.HypervisorHostname), strings.ToLower(f.HostnamePattern)) {
+		return false
+	}
+
+	if f.Hosts != nil && !f.Hosts[h.Service.Host] {
+		return false
+	}
+
+	return true
+}
+
+// flattenComputeHypervisorsV2 converts a list of hypervisors into a list of
+// maps.
+func flattenComputeHypervisorsV2(allHypervisors []hypervisors.Hypervisor) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(allHypervisors))
+
+	for _, h := range allHypervisors {
+		result = append(result, map[string]interface{}{
+			"id":          h.ID,
+			"hostname":    h.HypervisorHostname,
+			"host":        h.Service.Host,
+			"host_ip":     h.HostIP,
+			"state":       h.State,
+			"status":      h.Status,
+			"type":        h.HypervisorType,
+			"vcpus":       h.VCPUs,
+			"vcpus_used":  h.VCPUsUsed,
+			"memory":      h.MemoryMB,
+			"memory_used": h.MemoryMBUsed,
+			"disk":        h.LocalGB,
+			"disk_used":   h.LocalGBUsed,
+			"running_vms": h.RunningVMs,
+		})
+	}
+
+	return result
+}
+
+// computeHypervisorsV2Totals sums up the capacity of a list of hypervisors.
+// Free values may be negative on overcommitted hypervisors.
+func computeHypervisorsV2Totals(allHypervisors []hypervisors.Hypervisor) map[string]int {
+	totals := map[string]int{
+		"total_vcpus":       0,
+		"total_vcpus_used":  0,
+		"total_vcpus_free":  0,
+		"total_memory":      0,
+		"total_memory_used": 0,
+		"total_memory_free": 0,
+		"total_disk":        0,
+		"total_disk_used":   0,
+		"total_disk_free":   0,
+	}
+
+	for _, h := range allHypervisors {
+		totals["total_vcpus"] += h.VCPUs
+		totals["total_vcpus_used"] += h.VCPUsUsed
+		totals["total_memory"] += h.MemoryMB
+		totals["total_memory_used"] += h.MemoryMBUsed
+		totals["total_disk"] += h.LocalGB
+		totals["total_disk_used"] += h.LocalGBUsed
+	}
+
+	totals["total_vcpus_free"] = totals["total_vcpus"] - totals["total_vcpus_used"]
+	totals["total_memory_free"] = totals["total_memory"] - totals["total_memory_used"]
+	totals["total_disk_free"] = totals["total_disk"] - totals["total_disk_used"]
+
+	return totals
+}
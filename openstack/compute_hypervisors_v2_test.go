@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeHypervisorsV2FilterMatch(t *testing.T) {
+	h := hypervisors.Hypervisor{
+		HypervisorHostname: "compute-1.example.com",
+		HypervisorType:     "QEMU",
+		State:              "up",
+		Status:             "enabled",
+		Service: hypervisors.Service{
+			Host: "compute-1",
+		},
+	}
+
+	assert.True(t, computeHypervisorsV2Filter{}.match(h))
+	assert.True(t, computeHypervisorsV2Filter{State: "UP", Status: "enabled", Type: "qemu"}.match(h))
+	assert.False(t, computeHypervisorsV2Filter{State: "down"}.match(h))
+	assert.False(t, computeHypervisorsV2Filter{Status: "disabled"}.match(h))
+	assert.False(t, computeHypervisorsV2Filter{Type: "ironic"}.match(h))
+	assert.True(t, computeHypervisorsV2Filter{HostnamePattern: "Compute-1"}.match(h))
+	assert.False(t, computeHypervisorsV2Filter{HostnamePattern: "compute-2"}.match(h))
+	assert.True(t, computeHypervisorsV2Filter{Hosts: map[string]bool{"compute-1": true}}.match(h))
+	assert.False(t, computeHypervisorsV2Filter{Hosts: map[string]bool{}}.match(h))
+}
+
+func TestComputeHypervisorsV2Totals(t *testing.T) {
+	allHypervisors := []hypervisors.Hypervisor{
+		{
+			VCPUs:        8,
+			VCPUsUsed:    2,
+			MemoryMB:     16384,
+			MemoryMBUsed: 4096,
+			LocalGB:      100,
+			LocalGBUsed:  20,
+		},
+		{
+			VCPUs:        8,
+			VCPUsUsed:    10,
+			MemoryMB:     16384,
+			MemoryMBUsed: 2048,
+			LocalGB:      100,
+			LocalGBUsed:  0,
+		},
+	}
+
+	expected := map[string]int{
+		"total_vcpus":       16,
+		"total_vcpus_used":  12,
+		"total_vcpus_free":  4,
+		"total_memory":      32768,
+		"total_memory_used": 6144,
+		"total_memory_free": 26624,
+		"total_disk":        200,
+		"total_disk_used":   20,
+		"total_disk_free":   180,
+	}
+
+	assert.Equal(t, expected, computeHypervisorsV2Totals(allHypervisors))
+	assert.Equal(t, 0, computeHypervisorsV2Totals(nil)["total_vcpus"])
+}
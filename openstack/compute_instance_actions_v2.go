@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+)
+
+const (
+	computeV2InstanceActionsEventsMicroversion       = "2.51"
+	computeV2InstanceActionsChangesSinceMicroversion = "2.58"
+)
+
+// flattenComputeV2InstanceActionEvents converts the events of an instance
+// action into a list of maps.
+func flattenComputeV2InstanceActionEvents(events *[]instanceactions.Event) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if events == nil {
+		return result
+	}
+
+	for _, e := range *events {
+		var host string
+		if e.Host != nil {
+			host = *e.Host
+		}
+
+		var finishTime string
+		if !e.FinishTime.IsZero() {
+			finishTime = e.FinishTime.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"event":       e.Event,
+			"result":      e.Result,
+			"host":        host,
+			"traceback":   e.Traceback,
+			"start_time":  e.StartTime.Format(time.RFC3339),
+			"finish_time": finishTime,
+		})
+	}
+
+	return result
+}
+
+// flattenComputeV2InstanceAction converts an instance action and its events
+// into a map.
+func flattenComputeV2InstanceAction(action instanceactions.InstanceActionDetail) map[string]interface{} {
+	return map[string]interface{}{
+		"action":     action.Action,
+		"request_id": action.RequestID,
+		"user_id":    action.UserID,
+		"project_id": action.ProjectID,
+		"message":    action.Message,
+		"start_time": action.StartTime.Format(time.RFC3339),
+		"events":     flattenComputeV2InstanceActionEvents(action.Events),
+	}
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenComputeV2InstanceAction(t *testing.T) {
+	host := "compute-1"
+	events := []instanceactions.Event{
+		{
+			Event:      "compute_stop_instance",
+			Host:       &host,
+			Result:     "Success",
+			StartTime:  time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC),
+			FinishTime: time.Date(2020, 1, 2, 3, 4, 10, 0, time.UTC),
+		},
+	}
+
+	action := instanceactions.InstanceActionDetail{
+		Action:    "stop",
+		RequestID: "req-3293a3f1-b44c-4609-b8d2-d81b105636b8",
+		UserID:    "user_1",
+		ProjectID: "project_1",
+		StartTime: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Events:    &events,
+	}
+
+	expected := map[string]interface{}{
+		"action":     "stop",
+		"request_id": "req-3293a3f1-b44c-4609-b8d2-d81b105636b8",
+		"user_id":    "user_1",
+		"project_id": "project_1",
+		"message":    "",
+		"start_time": "2020-01-02T03:04:05Z",
+		"events": []map[string]interface{}{
+			{
+				"event":       "compute_stop_instance",
+				"result":      "Success",
+				"host":        "compute-1",
+				"traceback":   "",
+				"start_time":  "2020-01-02T03:04:06Z",
+				"finish_time": "2020-01-02T03:04:10Z",
+			},
+		},
+	}
+
+	actual := flattenComputeV2InstanceAction(action)
+	assert.Equal(t, expected, actual)
+}
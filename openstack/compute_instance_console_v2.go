@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+)
+
+const (
+	computeV2InstanceRemoteConsoleMicroversion    = "2.6"
+	computeV2InstanceRemoteConsoleMKSMicroversion = "2.8"
+)
+
+// computeV2InstanceConsoleProtocols maps the supported remote console types
+// to their protocols.
+var computeV2InstanceConsoleProtocols = map[remoteconsoles.ConsoleType]remoteconsoles.ConsoleProtocol{
+	remoteconsoles.ConsoleTypeNoVNC:      remoteconsoles.ConsoleProtocolVNC,
+	remoteconsoles.ConsoleTypeXVPVNC:     remoteconsoles.ConsoleProtocolVNC,
+	remoteconsoles.ConsoleTypeSPICEHTML5: remoteconsoles.ConsoleProtocolSPICE,
+	remoteconsoles.ConsoleTypeRDPHTML5:   remoteconsoles.ConsoleProtocolRDP,
+	remoteconsoles.ConsoleTypeSerial:     remoteconsoles.ConsoleProtocolSerial,
+	remoteconsoles.ConsoleTypeWebMKS:     remoteconsoles.ConsoleProtocolMKS,
+}
+
+func computeV2InstanceConsoleTypes() []string {
+	return []string{
+		string(remoteconsoles.ConsoleTypeNoVNC),
+		string(remoteconsoles.ConsoleTypeXVPVNC),
+		string(remoteconsoles.ConsoleTypeSPICEHTML5),
+		string(remoteconsoles.ConsoleTypeRDPHTML5),
+		string(remoteconsoles.ConsoleTypeSerial),
+		string(remoteconsoles.ConsoleTypeWebMKS),
+	}
+}
+
+// expandComputeV2InstanceRemoteConsoleCreateOpts builds the remote console
+// request for the given console type.
+func expandComputeV2InstanceRemoteConsoleCreateOpts(consoleType string) (remoteconsoles.CreateOpts, error) {
+	protocol, ok := computeV2InstanceConsoleProtocols[remoteconsoles.ConsoleType(consoleType)]
+	if !ok {
+		return remoteconsoles.CreateOpts{}, fmt.Errorf("Unsupported console type: %s", consoleType)
+	}
+
+	return remoteconsoles.CreateOpts{
+		Protocol: protocol,
+		Type:     remoteconsoles.ConsoleType(consoleType),
+	}, nil
+}
+
+// computeV2InstanceRemoteConsoleMicroversionFor returns the microversion
+// required to create a remote console with the given protocol.
+func computeV2InstanceRemoteConsoleMicroversionFor(protocol remoteconsoles.ConsoleProtocol) string {
+	if protocol == remoteconsoles.ConsoleProtocolMKS {
+		return computeV2InstanceRemoteConsoleMKSMicroversion
+	}
+
+	return computeV2InstanceRemoteConsoleMicroversion
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandComputeV2InstanceRemoteConsoleCreateOpts(t *testing.T) {
+	expected := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolSPICE,
+		Type:     remoteconsoles.ConsoleTypeSPICEHTML5,
+	}
+
+	actual, err := expandComputeV2InstanceRemoteConsoleCreateOpts("spice-html5")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = expandComputeV2InstanceRemoteConsoleCreateOpts("foo")
+	assert.Error(t, err)
+}
+
+func TestComputeV2InstanceRemoteConsoleMicroversionFor(t *testing.T) {
+	assert.Equal(t, "2.6", computeV2InstanceRemoteConsoleMicroversionFor(remoteconsoles.ConsoleProtocolVNC))
+	assert.Equal(t, "2.6", computeV2InstanceRemoteConsoleMicroversionFor(remoteconsoles.ConsoleProtocolSerial))
+	assert.Equal(t, "2.8", computeV2InstanceRemoteConsoleMicroversionFor(remoteconsoles.ConsoleProtocolMKS))
+}
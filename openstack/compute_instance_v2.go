@@ -13,12 +13,21 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/apiversions"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/rescueunrescue"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tenantnetworks"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	flavors_utils "github.com/gophercloud/utils/openstack/compute/v2/flavors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -27,8 +36,41 @@ const (
 	computeV2InstanceCreateServerWithTagsMicroversion        = "2.52"
 	computeV2TagsExtensionMicroversion                       = "2.26"
 	computeV2InstanceBlockDeviceVolumeTypeMicroversion       = "2.67"
+	computeV2InstanceRebuildKeyNameMicroversion              = "2.54"
+	computeV2InstanceRebuildUserDataMicroversion             = "2.57"
+	computeV2InstanceColdMigrateHostMicroversion             = "2.56"
+	computeV2InstanceLiveMigrateAutoMicroversion             = "2.25"
 )
 
+// computeV2MaxMicroversion returns the maximum microversion supported by the
+// Compute API. The result is cached, so the version is only retrieved once
+// per endpoint.
+func computeV2MaxMicroversion(config *Config, client *gophercloud.ServiceClient) (string, error) {
+	if v, ok := config.maxMicroversions.Load(client.Endpoint); ok {
+		return v.(string), nil
+	}
+
+	apiInfo, err := apiversions.Get(client, "v2.1").Extract()
+	if err != nil {
+		return "", err
+	}
+
+	config.maxMicroversions.Store(client.Endpoint, apiInfo.Version)
+
+	return apiInfo.Version, nil
+}
+
+// computeV2MicroversionSupported checks whether the Compute API supports
+// the given microversion.
+func computeV2MicroversionSupported(config *Config, client *gophercloud.ServiceClient, required string) (bool, error) {
+	maxMicroversion, err := computeV2MaxMicroversion(config, client)
+	if err != nil {
+		return false, err
+	}
+
+	return compatibleMicroversion("min", required, maxMicroversion)
+}
+
 // InstanceNIC is a structured representation of a Gophercloud servers.Server
 // virtual NIC.
 type InstanceNIC struct {
@@ -565,3 +607,277 @@ func computeV2InstanceUpdateTags(d *schema.ResourceData) []string {
 func computeV2InstanceTags(d *schema.ResourceData) []string {
 	return expandObjectTags(d)
 }
+
+// computeV2InstanceRebuildCustomizeDiff forces a new instance on image,
+// user_data or key_pair changes, unless rebuild_on_image_change is enabled.
+func computeV2InstanceRebuildCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	rebuildKeys := []string{"image_id", "image_name", "user_data", "key_pair"}
+
+	if !diff.Get("rebuild_on_image_change").(bool) {
+		for _, key := range rebuildKeys {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// The other image attribute is refreshed after the rebuild.
+	if diff.HasChange("image_id") && !diff.HasChange("image_name") {
+		return diff.SetNewComputed("image_name")
+	}
+
+	if diff.HasChange("image_name") && !diff.HasChange("image_id") {
+		return diff.SetNewComputed("image_id")
+	}
+
+	return nil
+}
+
+// computeV2InstanceMigrationCustomizeDiff marks the host as unknown when a
+// migration to another host is requested.
+func computeV2InstanceMigrationCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("requested_host") {
+		return nil
+	}
+
+	requestedHost := diff.Get("requested_host").(string)
+	if requestedHost == "" || requestedHost == diff.Get("host").(string) {
+		return nil
+	}
+
+	return diff.SetNewComputed("host")
+}
+
+// computeV2InstanceColdMigrateOpts represents the body of a cold migration
+// to a specific host. migrate.Migrate does not support a target host.
+type computeV2InstanceColdMigrateOpts struct {
+	Host string `json:"host,omitempty"`
+}
+
+// computeV2InstanceColdMigrate cold migrates an instance to the given host.
+func computeV2InstanceColdMigrate(client *gophercloud.ServiceClient, instanceID, host string) error {
+	b, err := BuildRequest(computeV2InstanceColdMigrateOpts{Host: host}, "migrate")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(client.ServiceURL("servers", instanceID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// computeV2InstanceLiveMigrateOpts represents the body of a live migration.
+// Since microversion 2.25 disk_over_commit is removed and block_migration
+// accepts "auto", which migrate.LiveMigrateOpts does not support.
+type computeV2InstanceLiveMigrateOpts struct {
+	Host           string      `json:"host"`
+	BlockMigration interface{} `json:"block_migration"`
+	DiskOverCommit *bool       `json:"disk_over_commit,omitempty"`
+}
+
+// computeV2InstanceLiveMigrate live migrates an instance to the given host.
+func computeV2InstanceLiveMigrate(client *gophercloud.ServiceClient, instanceID string, opts computeV2InstanceLiveMigrateOpts) error {
+	b, err := BuildRequest(opts, "os-migrateLive")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(client.ServiceURL("servers", instanceID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// computeV2InstanceMigrationStateRefreshFunc returns MIGRATED once the instance
+// runs on the given host, or VERIFY_RESIZE when a cold migration has to be
+// confirmed. An instance, which is back in service on another host without a
+// pending task, failed to migrate.
+func computeV2InstanceMigrationStateRefreshFunc(client *gophercloud.ServiceClient, instanceID, host string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var s struct {
+			servers.Server
+			extendedserverattributes.ServerAttributesExt
+			extendedstatus.ServerExtendedStatusExt
+		}
+
+		err := servers.Get(client, instanceID).ExtractInto(&s)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch s.Status {
+		case "ERROR":
+			return &s.Server, s.Status, fmt.Errorf("Instance is in ERROR state")
+		case "ACTIVE", "SHUTOFF":
+			if s.Host == host {
+				return &s.Server, "MIGRATED", nil
+			}
+
+			// The migration has been accepted, but hasn't started yet.
+			if s.TaskState != "" {
+				return &s.Server, "MIGRATING", nil
+			}
+
+			return &s.Server, "FAILED", fmt.Errorf("Instance failed to migrate and is still on host %s", s.Host)
+		}
+
+		return &s.Server, s.Status, nil
+	}
+}
+
+// computeV2InstanceResizePolicy is the expanded resize_policy block.
+type computeV2InstanceResizePolicy struct {
+	Mode          string
+	VerifyTimeout time.Duration
+	TimeoutAction string
+	CheckQuota    bool
+}
+
+func expandComputeV2InstanceResizePolicy(raw []interface{}) computeV2InstanceResizePolicy {
+	policy := computeV2InstanceResizePolicy{
+		Mode: "confirm",
+	}
+
+	if len(raw) == 0 || raw[0] == nil {
+		return policy
+	}
+
+	v := raw[0].(map[string]interface{})
+	policy.Mode = v["mode"].(string)
+	policy.TimeoutAction = v["timeout_action"].(string)
+	policy.CheckQuota = v["check_quota"].(bool)
+
+	// The duration was validated in the schema.
+	policy.VerifyTimeout, _ = time.ParseDuration(v["verify_timeout"].(string))
+
+	return policy
+}
+
+func validateComputeV2InstanceDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid duration, e.g. 30m: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+// computeV2InstanceResizePolicyCustomizeDiff rejects a resize_policy together
+// with the ignore_resize_confirmation vendor option, since both control the
+// confirmation of a resize.
+func computeV2InstanceResizePolicyCustomizeDiff(diff *schema.ResourceDiff) error {
+	if len(diff.Get("resize_policy").([]interface{})) == 0 {
+		return nil
+	}
+
+	vendorOptionsRaw := diff.Get("vendor_options").(*schema.Set)
+	if vendorOptionsRaw.Len() == 0 {
+		return nil
+	}
+
+	vendorOptions := expandVendorOptions(vendorOptionsRaw.List())
+	if ignore, ok := vendorOptions["ignore_resize_confirmation"].(bool); ok && ignore {
+		return fmt.Errorf("\"resize_policy\": conflicts with vendor_options.ignore_resize_confirmation")
+	}
+
+	return nil
+}
+
+// computeV2InstanceResizeQuotaCustomizeDiff checks whether a resize would
+// exceed the cores or RAM quota, when the check_quota of the resize_policy
+// is enabled.
+func computeV2InstanceResizeQuotaCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !(diff.HasChange("flavor_id") || diff.HasChange("flavor_name")) {
+		return nil
+	}
+
+	policy := expandComputeV2InstanceResizePolicy(diff.Get("resize_policy").([]interface{}))
+	if !policy.CheckQuota {
+		return nil
+	}
+
+	config := meta.(*Config)
+	region := config.Region
+	if v, ok := diff.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	oldFlavorID, newFlavorID := diff.GetChange("flavor_id")
+	if !diff.HasChange("flavor_id") {
+		// The new flavor may not be known yet.
+		if !diff.NewValueKnown("flavor_name") {
+			return nil
+		}
+
+		newFlavorID, err = flavors_utils.IDFromName(computeClient, diff.Get("flavor_name").(string))
+		if err != nil {
+			return err
+		}
+	} else if !diff.NewValueKnown("flavor_id") {
+		return nil
+	}
+
+	oldFlavor, err := flavors.Get(computeClient, oldFlavorID.(string)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack flavor %s: %s", oldFlavorID, err)
+	}
+
+	newFlavor, err := flavors.Get(computeClient, newFlavorID.(string)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack flavor %s: %s", newFlavorID, err)
+	}
+
+	computeLimits, err := limits.Get(computeClient, nil).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack compute limits: %s", err)
+	}
+
+	return computeV2InstanceCheckResizeQuota(computeLimits.Absolute, oldFlavor, newFlavor)
+}
+
+// computeV2InstanceCheckResizeQuota returns an error when resizing from the
+// old to the new flavor would exceed the absolute limits. A limit of -1 is
+// unlimited.
+func computeV2InstanceCheckResizeQuota(absolute limits.Absolute, oldFlavor, newFlavor *flavors.Flavor) error {
+	coresDelta := newFlavor.VCPUs - oldFlavor.VCPUs
+	if absolute.MaxTotalCores >= 0 && coresDelta > 0 && absolute.TotalCoresUsed+coresDelta > absolute.MaxTotalCores {
+		return fmt.Errorf("Resizing to flavor %s requires %d more cores, but only %d of %d are available",
+			newFlavor.Name, coresDelta, absolute.MaxTotalCores-absolute.TotalCoresUsed, absolute.MaxTotalCores)
+	}
+
+	ramDelta := newFlavor.RAM - oldFlavor.RAM
+	if absolute.MaxTotalRAMSize >= 0 && ramDelta > 0 && absolute.TotalRAMUsed+ramDelta > absolute.MaxTotalRAMSize {
+		return fmt.Errorf("Resizing to flavor %s requires %d MB more RAM, but only %d MB of %d MB are available",
+			newFlavor.Name, ramDelta, absolute.MaxTotalRAMSize-absolute.TotalRAMUsed, absolute.MaxTotalRAMSize)
+	}
+
+	return nil
+}
+
+func expandComputeV2InstanceRescueOpts(raw []interface{}) rescueunrescue.RescueOpts {
+	if len(raw) == 0 || raw[0] == nil {
+		return rescueunrescue.RescueOpts{}
+	}
+
+	v := raw[0].(map[string]interface{})
+
+	return rescueunrescue.RescueOpts{
+		AdminPass:      v["admin_pass"].(string),
+		RescueImageRef: v["rescue_image_id"].(string),
+	}
+}
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/rescueunrescue"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerRebuildOptsToServerRebuildMap(t *testing.T) {
+	preserveEphemeral := true
+	rebuildOpts := ServerRebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+			AdminPass: "foo",
+		},
+		KeyName:           "kp_1",
+		UserData:          "I2Nsb3VkLWNvbmZpZw==",
+		PreserveEphemeral: &preserveEphemeral,
+	}
+
+	expected := map[string]interface{}{
+		"rebuild": map[string]interface{}{
+			"imageRef":           "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"adminPass":          "foo",
+			"key_name":           "kp_1",
+			"user_data":          "I2Nsb3VkLWNvbmZpZw==",
+			"preserve_ephemeral": true,
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestComputeV2InstanceColdMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/f90f6034-2570-4974-8351-6b49732ef2eb/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"migrate": {"host": "compute-2"}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient()
+	err := computeV2InstanceColdMigrate(client, "f90f6034-2570-4974-8351-6b49732ef2eb", "compute-2")
+	assert.NoError(t, err)
+}
+
+func TestComputeV2InstanceLiveMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/f90f6034-2570-4974-8351-6b49732ef2eb/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"os-migrateLive": {"host": "compute-2", "block_migration": false, "disk_over_commit": false}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"os-migrateLive": {"host": "compute-2", "block_migration": "auto"}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := thclient.ServiceClient()
+
+	diskOverCommit := false
+	err := computeV2InstanceLiveMigrate(client, "f90f6034-2570-4974-8351-6b49732ef2eb", computeV2InstanceLiveMigrateOpts{
+		Host:           "compute-2",
+		BlockMigration: false,
+		DiskOverCommit: &diskOverCommit,
+	})
+	assert.NoError(t, err)
+
+	err = computeV2InstanceLiveMigrate(client, "9e5476bd-a4ec-4653-93d6-72c93aa682ba", computeV2InstanceLiveMigrateOpts{
+		Host:           "compute-2",
+		BlockMigration: "auto",
+	})
+	assert.NoError(t, err)
+}
+
+func TestExpandComputeV2InstanceResizePolicy(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"mode":           "manual",
+			"verify_timeout": "1h",
+			"timeout_action": "confirm",
+			"check_quota":    true,
+		},
+	}
+
+	expected := computeV2InstanceResizePolicy{
+		Mode:          "manual",
+		VerifyTimeout: time.Hour,
+		TimeoutAction: "confirm",
+		CheckQuota:    true,
+	}
+
+	assert.Equal(t, expected, expandComputeV2InstanceResizePolicy(raw))
+	assert.Equal(t, computeV2InstanceResizePolicy{Mode: "confirm"}, expandComputeV2InstanceResizePolicy([]interface{}{}))
+}
+
+func TestComputeV2InstanceCheckResizeQuota(t *testing.T) {
+	oldFlavor := &flavors.Flavor{Name: "m1.small", VCPUs: 1, RAM: 2048}
+	newFlavor := &flavors.Flavor{Name: "m1.medium", VCPUs: 2, RAM: 4096}
+
+	absolute := limits.Absolute{
+		MaxTotalCores:   10,
+		TotalCoresUsed:  9,
+		MaxTotalRAMSize: 51200,
+		TotalRAMUsed:    2048,
+	}
+	assert.NoError(t, computeV2InstanceCheckResizeQuota(absolute, oldFlavor, newFlavor))
+
+	absolute.TotalCoresUsed = 10
+	assert.Error(t, computeV2InstanceCheckResizeQuota(absolute, oldFlavor, newFlavor))
+
+	// Shrinking never exceeds the quota.
+	assert.NoError(t, computeV2InstanceCheckResizeQuota(absolute, newFlavor, oldFlavor))
+
+	absolute.TotalCoresUsed = 1
+	absolute.TotalRAMUsed = 50000
+	assert.Error(t, computeV2InstanceCheckResizeQuota(absolute, oldFlavor, newFlavor))
+
+	// A limit of -1 is unlimited.
+	absolute.MaxTotalRAMSize = -1
+	assert.NoError(t, computeV2InstanceCheckResizeQuota(absolute, oldFlavor, newFlavor))
+}
+
+func TestExpandComputeV2InstanceRescueOpts(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"rescue_image_id": "image-id",
+			"admin_pass":      "secret",
+		},
+	}
+
+	expected := rescueunrescue.RescueOpts{
+		AdminPass:      "secret",
+		RescueImageRef: "image-id",
+	}
+
+	assert.Equal(t, expected, expandComputeV2InstanceRescueOpts(raw))
+	assert.Equal(t, rescueunrescue.RescueOpts{}, expandComputeV2InstanceRescueOpts([]interface{}{nil}))
+}
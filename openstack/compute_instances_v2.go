@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// computeInstancesV2Server is a servers.Server with the availability zone
+// extension, as returned by the servers list API.
+type computeInstancesV2Server struct {
+	servers.Server
+	availabilityzones.ServerAvailabilityZoneExt
+}
+
+// computeInstancesV2MetadataMatch checks whether all key/value pairs of the
+// filter are present in the server metadata.
+func computeInstancesV2MetadataMatch(metadata map[string]string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		if value, ok := metadata[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenComputeInstancesV2Networks converts the addresses of a server into
+// a list of network maps.
+func flattenComputeInstancesV2Networks(addresses map[string]interface{}) []map[string]interface{} {
+	networks := []map[string]interface{}{}
+
+	for _, instanceAddresses := range getInstanceAddresses(addresses) {
+		for _, instanceNIC := range instanceAddresses.InstanceNICs {
+			networks = append(networks, map[string]interface{}{
+				"name":        instanceAddresses.NetworkName,
+				"fixed_ip_v4": instanceNIC.FixedIPv4,
+				"fixed_ip_v6": instanceNIC.FixedIPv6,
+				"mac":         instanceNIC.MAC,
+			})
+		}
+	}
+
+	return networks
+}
+
+// flattenComputeInstancesV2 converts a list of servers into a list of
+// instance maps.
+func flattenComputeInstancesV2(allServers []computeInstancesV2Server) []map[string]interface{} {
+	instances := make([]map[string]interface{}, 0, len(allServers))
+
+	for _, server := range allServers {
+		networks := flattenComputeInstancesV2Networks(server.Addresses)
+
+		// Prefer the first detected fixed addresses over AccessIPv4/v6.
+		hostv4, hostv6 := server.AccessIPv4, server.AccessIPv6
+		for _, n := range networks {
+			if v := n["fixed_ip_v4"].(string); v != "" {
+				hostv4 = v
+				break
+			}
+		}
+		for _, n := range networks {
+			if v := n["fixed_ip_v6"].(string); v != "" {
+				hostv6 = v
+				break
+			}
+		}
+
+		secGrpNames := []string{}
+		for _, sg := range server.SecurityGroups {
+			if name, ok := sg["name"].(string); ok {
+				secGrpNames = append(secGrpNames, name)
+			}
+		}
+
+		var imageID string
+		if v, ok := server.Image["id"].(string); ok {
+			imageID = v
+		}
+
+		var flavorID string
+		if v, ok := server.Flavor["id"].(string); ok {
+			flavorID = v
+		}
+
+		var serverTags []string
+		if server.Tags != nil {
+			serverTags = *server.Tags
+		}
+
+		instances = append(instances, map[string]interface{}{
+			"id":                server.ID,
+			"name":              server.Name,
+			"status":            server.Status,
+			"image_id":          imageID,
+			"flavor_id":         flavorID,
+			"availability_zone": server.AvailabilityZone,
+			"tenant_id":         server.TenantID,
+			"user_id":           server.UserID,
+			"key_pair":          server.KeyName,
+			"security_groups":   secGrpNames,
+			"access_ip_v4":      hostv4,
+			"access_ip_v6":      hostv6,
+			"metadata":          server.Metadata,
+			"tags":              serverTags,
+			"network":           networks,
+		})
+	}
+
+	return instances
+}
@@ -0,0 +1,87 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeInstancesV2MetadataMatch(t *testing.T) {
+	metadata := map[string]string{
+		"role": "web",
+		"env":  "prod",
+	}
+
+	assert.True(t, computeInstancesV2MetadataMatch(metadata, map[string]interface{}{}))
+	assert.True(t, computeInstancesV2MetadataMatch(metadata, map[string]interface{}{"role": "web"}))
+	assert.False(t, computeInstancesV2MetadataMatch(metadata, map[string]interface{}{"role": "db"}))
+	assert.False(t, computeInstancesV2MetadataMatch(metadata, map[string]interface{}{"owner": "web"}))
+}
+
+func TestFlattenComputeInstancesV2(t *testing.T) {
+	serverTags := []string{"web"}
+	allServers := []computeInstancesV2Server{
+		{
+			Server: servers.Server{
+				ID:       "f90f6034-2570-4974-8351-6b49732ef2eb",
+				Name:     "instance_1",
+				Status:   "ACTIVE",
+				TenantID: "tenant_1",
+				UserID:   "user_1",
+				KeyName:  "kp_1",
+				Image:    map[string]interface{}{"id": "image_1"},
+				Flavor:   map[string]interface{}{"id": "flavor_1"},
+				Addresses: map[string]interface{}{
+					"private": []interface{}{
+						map[string]interface{}{
+							"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:00:00:01",
+							"OS-EXT-IPS:type":         "fixed",
+							"addr":                    "10.0.0.10",
+							"version":                 float64(4),
+						},
+					},
+				},
+				Metadata: map[string]string{"role": "web"},
+				SecurityGroups: []map[string]interface{}{
+					{"name": "default"},
+				},
+				Tags: &serverTags,
+			},
+			ServerAvailabilityZoneExt: availabilityzones.ServerAvailabilityZoneExt{
+				AvailabilityZone: "nova",
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":                "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"name":              "instance_1",
+			"status":            "ACTIVE",
+			"image_id":          "image_1",
+			"flavor_id":         "flavor_1",
+			"availability_zone": "nova",
+			"tenant_id":         "tenant_1",
+			"user_id":           "user_1",
+			"key_pair":          "kp_1",
+			"security_groups":   []string{"default"},
+			"access_ip_v4":      "10.0.0.10",
+			"access_ip_v6":      "",
+			"metadata":          map[string]string{"role": "web"},
+			"tags":              []string{"web"},
+			"network": []map[string]interface{}{
+				{
+					"name":        "private",
+					"fixed_ip_v4": "10.0.0.10",
+					"fixed_ip_v6": "",
+					"mac":         "fa:16:3e:00:00:01",
+				},
+			},
+		},
+	}
+
+	actual := flattenComputeInstancesV2(allServers)
+	assert.Equal(t, expected, actual)
+}
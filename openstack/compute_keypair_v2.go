@@ -1,7 +1,28 @@
 package openstack
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	computeKeyPairV2TypeMicroversion   = "2.2"
+	computeKeyPairV2UserIDMicroversion = "2.10"
+
+	computeKeyPairV2DefaultRSAKeySize   = 4096
+	computeKeyPairV2DefaultECDSAKeySize = 256
 )
 
 // ComputeKeyPairV2CreateOpts is a custom KeyPair struct to include the ValueSpecs field.
@@ -15,3 +36,188 @@ type ComputeKeyPairV2CreateOpts struct {
 func (opts ComputeKeyPairV2CreateOpts) ToKeyPairCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "keypair")
 }
+
+// computeKeyPairV2Microversion returns the microversion required to manage
+// a keypair with the given type and user.
+func computeKeyPairV2Microversion(keyType, userID string) string {
+	if userID != "" {
+		return computeKeyPairV2UserIDMicroversion
+	}
+
+	if keyType != "" {
+		return computeKeyPairV2TypeMicroversion
+	}
+
+	return ""
+}
+
+// computeKeyPairV2NegotiateMicroversion returns the microversion required to
+// manage a keypair with the given type and user. It fails, when the Compute
+// API doesn't support that microversion.
+func computeKeyPairV2NegotiateMicroversion(config *Config, client *gophercloud.ServiceClient, keyType, userID string) (string, error) {
+	microversion := computeKeyPairV2Microversion(keyType, userID)
+	if microversion == "" {
+		return "", nil
+	}
+
+	supported, err := computeV2MicroversionSupported(config, client, microversion)
+	if err != nil {
+		return "", fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+	}
+
+	if !supported {
+		return "", fmt.Errorf("Managing a keypair with a key_type or user_id requires microversion %s, "+
+			"which isn't supported by the compute API", microversion)
+	}
+
+	return microversion, nil
+}
+
+// computeKeyPairV2GenerateKey generates a private key of the given algorithm
+// and size. It returns the PEM encoded private key and the public key in the
+// OpenSSH authorized_keys format.
+func computeKeyPairV2GenerateKey(algorithm string, size int) (string, string, error) {
+	var privateKeyPEM *pem.Block
+	var publicKey interface{}
+
+	switch algorithm {
+	case "rsa":
+		if size == 0 {
+			size = computeKeyPairV2DefaultRSAKeySize
+		}
+		if size < 2048 {
+			return "", "", fmt.Errorf("Invalid key size for rsa: %d, must be at least 2048", size)
+		}
+
+		key, err := rsa.GenerateKey(rand.Reader, size)
+		if err != nil {
+			return "", "", err
+		}
+
+		privateKeyPEM = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}
+		publicKey = &key.PublicKey
+	case "ecdsa":
+		if size == 0 {
+			size = computeKeyPairV2DefaultECDSAKeySize
+		}
+
+		var curve elliptic.Curve
+		switch size {
+		case 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return "", "", fmt.Errorf("Invalid key size for ecdsa: %d, must be one of 256, 384 or 521", size)
+		}
+
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return "", "", err
+		}
+
+		privateKeyPEM = &pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: der,
+		}
+		publicKey = &key.PublicKey
+	case "ed25519":
+		if size != 0 {
+			return "", "", fmt.Errorf("The key size can't be set for ed25519")
+		}
+
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+
+		der, err := computeKeyPairV2MarshalED25519PrivateKey(pub, key)
+		if err != nil {
+			return "", "", err
+		}
+
+		privateKeyPEM = &pem.Block{
+			Type:  "OPENSSH PRIVATE KEY",
+			Bytes: der,
+		}
+		publicKey = pub
+	default:
+		return "", "", fmt.Errorf("Unsupported key algorithm: %s", algorithm)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(pem.EncodeToMemory(privateKeyPEM)),
+		strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey))), nil
+}
+
+// computeKeyPairV2MarshalED25519PrivateKey encodes an ed25519 private key in
+// the unencrypted OpenSSH private key format, since ed25519 keys have no
+// PEM format supported by OpenSSH.
+func computeKeyPairV2MarshalED25519PrivateKey(pub ed25519.PublicKey, key ed25519.PrivateKey) ([]byte, error) {
+	pubKey := ssh.Marshal(struct {
+		KeyType string
+		Pub     []byte
+	}{
+		ssh.KeyAlgoED25519,
+		pub,
+	})
+
+	var check [4]byte
+	if _, err := rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+	checkInt := binary.BigEndian.Uint32(check[:])
+
+	privKey := struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{
+		Check1:  checkInt,
+		Check2:  checkInt,
+		KeyType: ssh.KeyAlgoED25519,
+		Pub:     pub,
+		Priv:    key,
+	}
+
+	// The private block has to be padded to the cipher block size of 8.
+	blockLen := len(ssh.Marshal(privKey))
+	for i := 0; (blockLen+i)%8 != 0; i++ {
+		privKey.Pad = append(privKey.Pad, byte(i+1))
+	}
+
+	w := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       pubKey,
+		PrivKeyBlock: ssh.Marshal(privKey),
+	}
+
+	return append([]byte("openssh-key-v1\x00"), ssh.Marshal(w)...), nil
+}
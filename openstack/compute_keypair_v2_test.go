@@ -2,9 +2,12 @@ package openstack
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestComputeKeyPairV2CreateOpts(t *testing.T) {
@@ -33,3 +36,42 @@ func TestComputeKeyPairV2CreateOpts(t *testing.T) {
 		t.Fatalf("Maps differ. Want: %#v, but got: %#v", expected, actual)
 	}
 }
+
+func TestComputeKeyPairV2Microversion(t *testing.T) {
+	assert.Equal(t, "", computeKeyPairV2Microversion("", ""))
+	assert.Equal(t, "2.2", computeKeyPairV2Microversion("ssh", ""))
+	assert.Equal(t, "2.10", computeKeyPairV2Microversion("ssh", "user_1"))
+}
+
+func TestComputeKeyPairV2GenerateKey(t *testing.T) {
+	testCases := []struct {
+		algorithm string
+		size      int
+		keyType   string
+	}{
+		{"rsa", 2048, ssh.KeyAlgoRSA},
+		{"ecdsa", 0, ssh.KeyAlgoECDSA256},
+		{"ecdsa", 384, ssh.KeyAlgoECDSA384},
+		{"ed25519", 0, ssh.KeyAlgoED25519},
+	}
+
+	for _, tc := range testCases {
+		privateKey, publicKey, err := computeKeyPairV2GenerateKey(tc.algorithm, tc.size)
+		assert.NoError(t, err)
+
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		assert.NoError(t, err)
+
+		assert.Equal(t, tc.keyType, signer.PublicKey().Type())
+		assert.Equal(t, publicKey, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))))
+	}
+
+	_, _, err := computeKeyPairV2GenerateKey("rsa", 1024)
+	assert.Error(t, err)
+
+	_, _, err = computeKeyPairV2GenerateKey("ecdsa", 512)
+	assert.Error(t, err)
+
+	_, _, err = computeKeyPairV2GenerateKey("ed25519", 256)
+	assert.Error(t, err)
+}
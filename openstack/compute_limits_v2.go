@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+)
+
+// flattenComputeLimitsV2Absolute converts the absolute limits of a project
+// into a map keyed by the attribute names of openstack_compute_limits_v2.
+func flattenComputeLimitsV2Absolute(absolute limits.Absolute) map[string]int {
+	return map[string]int{
+		"max_total_cores":            absolute.MaxTotalCores,
+		"max_image_meta":             absolute.MaxImageMeta,
+		"max_server_meta":            absolute.MaxServerMeta,
+		"max_personality":            absolute.MaxPersonality,
+		"max_personality_size":       absolute.MaxPersonalitySize,
+		"max_total_keypairs":         absolute.MaxTotalKeypairs,
+		"max_security_groups":        absolute.MaxSecurityGroups,
+		"max_security_group_rules":   absolute.MaxSecurityGroupRules,
+		"max_server_groups":          absolute.MaxServerGroups,
+		"max_server_group_members":   absolute.MaxServerGroupMembers,
+		"max_total_floating_ips":     absolute.MaxTotalFloatingIps,
+		"max_total_instances":        absolute.MaxTotalInstances,
+		"max_total_ram_size":         absolute.MaxTotalRAMSize,
+		"total_cores_used":           absolute.TotalCoresUsed,
+		"total_instances_used":       absolute.TotalInstancesUsed,
+		"total_floating_ips_used":    absolute.TotalFloatingIpsUsed,
+		"total_ram_used":             absolute.TotalRAMUsed,
+		"total_security_groups_used": absolute.TotalSecurityGroupsUsed,
+		"total_server_groups_used":   absolute.TotalServerGroupsUsed,
+	}
+}
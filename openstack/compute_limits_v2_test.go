@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenComputeLimitsV2Absolute(t *testing.T) {
+	absolute := limits.Absolute{
+		MaxTotalCores:      20,
+		MaxTotalInstances:  10,
+		MaxTotalRAMSize:    51200,
+		TotalCoresUsed:     4,
+		TotalInstancesUsed: 2,
+		TotalRAMUsed:       4096,
+	}
+
+	actual := flattenComputeLimitsV2Absolute(absolute)
+
+	assert.Len(t, actual, 19)
+	assert.Equal(t, 20, actual["max_total_cores"])
+	assert.Equal(t, 10, actual["max_total_instances"])
+	assert.Equal(t, 51200, actual["max_total_ram_size"])
+	assert.Equal(t, 4, actual["total_cores_used"])
+	assert.Equal(t, 2, actual["total_instances_used"])
+	assert.Equal(t, 4096, actual["total_ram_used"])
+	assert.Equal(t, 0, actual["max_server_groups"])
+}
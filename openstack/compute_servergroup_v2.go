@@ -1,13 +1,19 @@
 package openstack
 
 import (
+	"fmt"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
+	antiAffinityPolicy     = "anti-affinity"
 	softAntiAffinityPolicy = "soft-anti-affinity"
 	softAffinityPolicy     = "soft-affinity"
+
+	computeServerGroupV2PolicyRulesMicroversion = "2.64"
 )
 
 // ServerGroupCreateOpts is a custom ServerGroup struct to include the
@@ -37,3 +43,55 @@ func expandComputeServerGroupV2Policies(client *gophercloud.ServiceClient, raw [
 
 	return policies
 }
+
+func expandComputeServerGroupV2Rules(raw []interface{}) *servergroups.Rules {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+
+	v := raw[0].(map[string]interface{})
+
+	return &servergroups.Rules{
+		MaxServerPerHost: v["max_server_per_host"].(int),
+	}
+}
+
+func flattenComputeServerGroupV2Rules(rules *servergroups.Rules) []map[string]interface{} {
+	if rules == nil || rules.MaxServerPerHost == 0 {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"max_server_per_host": rules.MaxServerPerHost,
+		},
+	}
+}
+
+// computeServerGroupV2CustomizeDiff rejects policy combinations, which are
+// not supported by the Compute API.
+func computeServerGroupV2CustomizeDiff(diff *schema.ResourceDiff) error {
+	if policies := diff.Get("policies").([]interface{}); len(policies) > 1 {
+		return fmt.Errorf("Only one of policies can be set for openstack_compute_servergroup_v2, got %d", len(policies))
+	}
+
+	rules := diff.Get("rules").([]interface{})
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// The policy may still be unknown, e.g. when it's interpolated.
+	policy, ok := diff.GetOk("policy")
+	if !ok {
+		if diff.NewValueKnown("policy") {
+			return fmt.Errorf("rules require the policy to be set for openstack_compute_servergroup_v2")
+		}
+		return nil
+	}
+
+	if policy.(string) != antiAffinityPolicy {
+		return fmt.Errorf("rules are only supported with the %s policy for openstack_compute_servergroup_v2, got %s", antiAffinityPolicy, policy)
+	}
+
+	return nil
+}
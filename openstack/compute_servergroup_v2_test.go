@@ -77,3 +77,62 @@ func TestExpandComputeServerGroupV2PoliciesMicroversions(t *testing.T) {
 	assert.Equal(t, expectedMicroversion, actualMicroversion)
 	assert.Equal(t, expectedPolicies, actualPolicies)
 }
+
+func TestComputeServerGroupV2CreateOptsPolicyRules(t *testing.T) {
+	createOpts := ComputeServerGroupV2CreateOpts{
+		servergroups.CreateOpts{
+			Name:   "foo",
+			Policy: "anti-affinity",
+			Rules: &servergroups.Rules{
+				MaxServerPerHost: 2,
+			},
+		},
+		nil,
+	}
+
+	expected := map[string]interface{}{
+		"server_group": map[string]interface{}{
+			"name":   "foo",
+			"policy": "anti-affinity",
+			"rules": map[string]interface{}{
+				"max_server_per_host": float64(2),
+			},
+		},
+	}
+
+	actual, err := createOpts.ToServerGroupCreateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandComputeServerGroupV2Rules(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"max_server_per_host": 2,
+		},
+	}
+
+	expected := &servergroups.Rules{
+		MaxServerPerHost: 2,
+	}
+
+	assert.Equal(t, expected, expandComputeServerGroupV2Rules(raw))
+	assert.Nil(t, expandComputeServerGroupV2Rules([]interface{}{}))
+}
+
+func TestFlattenComputeServerGroupV2Rules(t *testing.T) {
+	rules := &servergroups.Rules{
+		MaxServerPerHost: 2,
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"max_server_per_host": 2,
+		},
+	}
+
+	assert.Equal(t, expected, flattenComputeServerGroupV2Rules(rules))
+	assert.Empty(t, flattenComputeServerGroupV2Rules(&servergroups.Rules{}))
+	assert.Empty(t, flattenComputeServerGroupV2Rules(nil))
+}
@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	// computeServiceV2Microversion is the minimum microversion to update a
+	// service by its ID.
+	computeServiceV2Microversion = "2.53"
+
+	// computeServiceV2ForcedDownMicroversion is the minimum microversion to
+	// force a service down by its host and binary.
+	computeServiceV2ForcedDownMicroversion = "2.11"
+)
+
+// computeServiceV2UpdateOpts represents the body of a service update.
+// services.UpdateOpts omits forced_down, when it is false, so a service
+// can't be forced up again.
+type computeServiceV2UpdateOpts struct {
+	Status         services.ServiceStatus `json:"status,omitempty"`
+	DisabledReason string                 `json:"disabled_reason,omitempty"`
+	ForcedDown     *bool                  `json:"forced_down,omitempty"`
+}
+
+// computeServiceV2Update updates the service with the given ID.
+func computeServiceV2Update(client *gophercloud.ServiceClient, id string, opts computeServiceV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("os-services", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// computeServiceV2LegacyUpdateOpts represents the body of a service update
+// by its host and binary.
+type computeServiceV2LegacyUpdateOpts struct {
+	Host           string `json:"host"`
+	Binary         string `json:"binary"`
+	DisabledReason string `json:"disabled_reason,omitempty"`
+	ForcedDown     *bool  `json:"forced_down,omitempty"`
+}
+
+// computeServiceV2NegotiateMicroversion returns the newest microversion of
+// the services API, which is supported by the Compute API. An empty string
+// is returned, when neither is supported.
+func computeServiceV2NegotiateMicroversion(config *Config, client *gophercloud.ServiceClient) (string, error) {
+	for _, microversion := range []string{computeServiceV2Microversion, computeServiceV2ForcedDownMicroversion} {
+		supported, err := computeV2MicroversionSupported(config, client, microversion)
+		if err != nil {
+			return "", fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if supported {
+			return microversion, nil
+		}
+	}
+
+	return "", nil
+}
+
+// computeServiceV2UpdateService updates a service by its ID, when the client
+// uses microversion 2.53, or by its host and binary otherwise.
+func computeServiceV2UpdateService(client *gophercloud.ServiceClient, service *services.Service, opts computeServiceV2UpdateOpts) error {
+	if client.Microversion == computeServiceV2Microversion {
+		return computeServiceV2Update(client, service.ID, opts)
+	}
+
+	return computeServiceV2UpdateLegacy(client, service.Host, service.Binary, opts)
+}
+
+// computeServiceV2UpdateLegacy updates the service of a binary on a host with
+// the actions, which preceded microversion 2.53.
+func computeServiceV2UpdateLegacy(client *gophercloud.ServiceClient, host, binary string, opts computeServiceV2UpdateOpts) error {
+	legacyOpts := computeServiceV2LegacyUpdateOpts{
+		Host:   host,
+		Binary: binary,
+	}
+
+	var action string
+	switch {
+	case opts.Status == services.ServiceEnabled:
+		action = "enable"
+	case opts.Status == services.ServiceDisabled && opts.DisabledReason != "":
+		action = "disable-log-reason"
+		legacyOpts.DisabledReason = opts.DisabledReason
+	case opts.Status == services.ServiceDisabled:
+		action = "disable"
+	}
+
+	if action != "" {
+		if err := computeServiceV2LegacyAction(client, action, legacyOpts); err != nil {
+			return err
+		}
+	}
+
+	if opts.ForcedDown == nil {
+		return nil
+	}
+
+	if client.Microversion != computeServiceV2ForcedDownMicroversion {
+		if *opts.ForcedDown {
+			return fmt.Errorf("Forcing a service down requires microversion %s, "+
+				"which isn't supported by the compute API", computeServiceV2ForcedDownMicroversion)
+		}
+
+		return nil
+	}
+
+	legacyOpts.DisabledReason = ""
+	legacyOpts.ForcedDown = opts.ForcedDown
+
+	return computeServiceV2LegacyAction(client, "force-down", legacyOpts)
+}
+
+func computeServiceV2LegacyAction(client *gophercloud.ServiceClient, action string, opts computeServiceV2LegacyUpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("os-services", action), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// computeServiceV2Get returns the service of a binary on a host.
+func computeServiceV2Get(client *gophercloud.ServiceClient, host, binary string) (*services.Service, error) {
+	allPages, err := services.List(client, services.ListOpts{Host: host, Binary: binary}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allServices, err := services.ExtractServices(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range allServices {
+		if s.Host == host && s.Binary == binary {
+			return &s, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{
+		ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{
+			BaseError: gophercloud.BaseError{
+				DefaultErrString: fmt.Sprintf("Unable to find service %s on host %s", binary, host),
+			},
+		},
+	}
+}
+
+// computeServiceV2FromState returns the identifying fields of the service
+// stored in the state.
+func computeServiceV2FromState(d *schema.ResourceData) *services.Service {
+	return &services.Service{
+		ID:     d.Get("service_id").(string),
+		Host:   d.Get("host").(string),
+		Binary: d.Get("binary").(string),
+	}
+}
+
+// computeServiceV2CustomizeDiff rejects a disabled_reason for an enabled
+// service, which is refused by the API.
+func computeServiceV2CustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Get("status").(string) == string(services.ServiceEnabled) && diff.Get("disabled_reason").(string) != "" {
+		return fmt.Errorf("disabled_reason can only be set, when status is %q", services.ServiceDisabled)
+	}
+
+	return nil
+}
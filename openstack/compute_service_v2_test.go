@@ -0,0 +1,108 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeServiceV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-services/e81d66a4-ddd3-4aba-8a84-171d1cb4d339", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"status": "enabled", "forced_down": false}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"service": {}}`)
+	})
+
+	forcedDown := false
+	opts := computeServiceV2UpdateOpts{
+		Status:     "enabled",
+		ForcedDown: &forcedDown,
+	}
+
+	client := thclient.ServiceClient()
+	err := computeServiceV2Update(client, "e81d66a4-ddd3-4aba-8a84-171d1cb4d339", opts)
+	assert.NoError(t, err)
+}
+
+func TestComputeServiceV2UpdateLegacy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-services/disable-log-reason", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"host": "compute-1", "binary": "nova-compute", "disabled_reason": "maintenance"}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"service": {}}`)
+	})
+
+	th.Mux.HandleFunc("/os-services/force-down", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.11")
+		th.TestJSONRequest(t, r, `{"host": "compute-1", "binary": "nova-compute", "forced_down": true}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"service": {}}`)
+	})
+
+	forcedDown := true
+	opts := computeServiceV2UpdateOpts{
+		Status:         "disabled",
+		DisabledReason: "maintenance",
+		ForcedDown:     &forcedDown,
+	}
+
+	client := thclient.ServiceClient()
+	client.Type = "compute"
+	client.Microversion = computeServiceV2ForcedDownMicroversion
+	err := computeServiceV2UpdateLegacy(client, "compute-1", "nova-compute", opts)
+	assert.NoError(t, err)
+
+	client.Microversion = ""
+	err = computeServiceV2UpdateLegacy(client, "compute-1", "nova-compute", opts)
+	assert.Error(t, err)
+}
+
+func TestComputeServiceV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		assert.Equal(t, "compute-1", r.URL.Query().Get("host"))
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "services": [
+    {
+      "id": "e81d66a4-ddd3-4aba-8a84-171d1cb4d339",
+      "binary": "nova-compute",
+      "host": "compute-1",
+      "status": "disabled",
+      "disabled_reason": "maintenance",
+      "forced_down": false,
+      "state": "up",
+      "zone": "nova",
+      "updated_at": "2020-01-02T03:04:05.000000"
+    }
+  ]
+}`)
+	})
+
+	client := thclient.ServiceClient()
+
+	service, err := computeServiceV2Get(client, "compute-1", "nova-compute")
+	assert.NoError(t, err)
+	assert.Equal(t, "e81d66a4-ddd3-4aba-8a84-171d1cb4d339", service.ID)
+	assert.Equal(t, "maintenance", service.DisabledReason)
+
+	_, err = computeServiceV2Get(client, "compute-1", "nova-scheduler")
+	assert.IsType(t, gophercloud.ErrDefault404{}, err)
+}
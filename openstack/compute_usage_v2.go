@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/usage"
+)
+
+// computeUsageV2Totals holds the usage totals of a project, summed up over
+// all pages of the os-simple-tenant-usage API.
+type computeUsageV2Totals struct {
+	TotalHours         float64
+	TotalLocalGBUsage  float64
+	TotalMemoryMBUsage float64
+	TotalVCPUsUsage    float64
+}
+
+// add sums up the totals of a single page of tenant usage.
+func (t *computeUsageV2Totals) add(u *usage.TenantUsage) {
+	t.TotalHours += u.TotalHours
+	t.TotalLocalGBUsage += u.TotalLocalGBUsage
+	t.TotalMemoryMBUsage += u.TotalMemoryMBUsage
+	t.TotalVCPUsUsage += u.TotalVCPUsUsage
+}
+
+// flattenComputeUsageV2ServerUsages converts the server usages of a project
+// into a list of maps.
+func flattenComputeUsageV2ServerUsages(serverUsages []usage.ServerUsage) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(serverUsages))
+
+	for _, s := range serverUsages {
+		var endedAt string
+		if !s.EndedAt.IsZero() {
+			endedAt = s.EndedAt.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"instance_id": s.InstanceID,
+			"name":        s.Name,
+			"flavor":      s.Flavor,
+			"state":       s.State,
+			"hours":       s.Hours,
+			"vcpus":       s.VCPUs,
+			"memory_mb":   s.MemoryMB,
+			"local_gb":    s.LocalGB,
+			"uptime":      s.Uptime,
+			"started_at":  s.StartedAt.Format(time.RFC3339),
+			"ended_at":    endedAt,
+		})
+	}
+
+	return result
+}
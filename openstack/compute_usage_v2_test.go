@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/usage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeUsageV2TotalsAdd(t *testing.T) {
+	var totals computeUsageV2Totals
+
+	totals.add(&usage.TenantUsage{
+		TotalHours:         1.5,
+		TotalLocalGBUsage:  10,
+		TotalMemoryMBUsage: 512,
+		TotalVCPUsUsage:    1.5,
+	})
+	totals.add(&usage.TenantUsage{
+		TotalHours:         2,
+		TotalLocalGBUsage:  20,
+		TotalMemoryMBUsage: 1024,
+		TotalVCPUsUsage:    4,
+	})
+
+	expected := computeUsageV2Totals{
+		TotalHours:         3.5,
+		TotalLocalGBUsage:  30,
+		TotalMemoryMBUsage: 1536,
+		TotalVCPUsUsage:    5.5,
+	}
+
+	assert.Equal(t, expected, totals)
+}
+
+func TestFlattenComputeUsageV2ServerUsages(t *testing.T) {
+	serverUsages := []usage.ServerUsage{
+		{
+			InstanceID: "instance_1",
+			Name:       "instance_1",
+			Flavor:     "m1.tiny",
+			State:      "active",
+			Hours:      1.5,
+			VCPUs:      1,
+			MemoryMB:   512,
+			LocalGB:    1,
+			Uptime:     5400,
+			StartedAt:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"instance_id": "instance_1",
+			"name":        "instance_1",
+			"flavor":      "m1.tiny",
+			"state":       "active",
+			"hours":       1.5,
+			"vcpus":       1,
+			"memory_mb":   512,
+			"local_gb":    1,
+			"uptime":      5400,
+			"started_at":  "2020-01-02T03:04:05Z",
+			"ended_at":    "",
+		},
+	}
+
+	assert.Equal(t, expected, flattenComputeUsageV2ServerUsages(serverUsages))
+}
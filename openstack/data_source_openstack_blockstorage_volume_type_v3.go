@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceBlockStorageVolumeTypeV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageVolumeTypeV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"qos_specs_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageVolumeTypeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	allPages, err := volumetypes.List(client, nil).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_blockstorage_volume_type_v3: %s", err)
+	}
+
+	allVolumeTypes, err := volumetypes.ExtractVolumeTypes(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_blockstorage_volume_type_v3: %s", err)
+	}
+
+	name := d.Get("name").(string)
+
+	var refinedVolumeTypes []volumetypes.VolumeType
+	for _, vt := range allVolumeTypes {
+		if vt.Name == name {
+			refinedVolumeTypes = append(refinedVolumeTypes, vt)
+		}
+	}
+
+	if len(refinedVolumeTypes) < 1 {
+		return fmt.Errorf("Your openstack_blockstorage_volume_type_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedVolumeTypes) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_blockstorage_volume_type_v3 results found: %#v", refinedVolumeTypes)
+
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	vt := refinedVolumeTypes[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_type_v3 %s: %#v", vt.ID, vt)
+
+	d.SetId(vt.ID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", vt.Name)
+	d.Set("description", vt.Description)
+	d.Set("is_public", vt.IsPublic)
+	d.Set("qos_specs_id", vt.QosSpecID)
+
+	if err := d.Set("extra_specs", vt.ExtraSpecs); err != nil {
+		log.Printf("[DEBUG] Unable to set extra_specs for openstack_blockstorage_volume_type_v3 %s: %s", vt.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTypeDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_blockstorage_volume_type_v3.volume_type_1"
+	volumeTypeName := acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeExtraSpecs1(volumeTypeName),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeTypeDataSourceBasic(volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeDataSourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", volumeTypeName),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.volume_backend_name", "ssd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find volume type data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Volume type data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3VolumeTypeDataSourceBasic(volumeTypeName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "${openstack_blockstorage_volume_type_v3.volume_type_1.name}"
+}
+`, testAccBlockStorageV3VolumeTypeExtraSpecs1(volumeTypeName))
+}
@@ -0,0 +1,273 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeHypervisorsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeHypervisorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"hostname_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"up", "down"}, true),
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, true),
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"aggregate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// computed-only
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"hypervisors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vcpus_used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running_vms": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_vcpus_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_vcpus_free": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_memory_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_memory_free": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_disk": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_disk_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_disk_free": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeHypervisorsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	filter := computeHypervisorsV2Filter{
+		State:  d.Get("state").(string),
+		Status: d.Get("status").(string),
+		Type:   d.Get("type").(string),
+	}
+
+	// Hypervisors don't know their aggregates, so the aggregate is resolved
+	// into the compute hosts of its members.
+	if name := d.Get("aggregate").(string); name != "" {
+		allPages, err := aggregates.List(computeClient).AllPages()
+		if err != nil {
+			return fmt.Errorf("Error listing compute aggregates: %s", err)
+		}
+
+		allAggregates, err := aggregates.ExtractAggregates(allPages)
+		if err != nil {
+			return fmt.Errorf("Error extracting compute aggregates: %s", err)
+		}
+
+		for _, aggregate := range allAggregates {
+			if aggregate.Name != name {
+				continue
+			}
+			if filter.Hosts != nil {
+				return fmt.Errorf("More than one host aggregate found with this name: %s", name)
+			}
+
+			filter.Hosts = make(map[string]bool, len(aggregate.Hosts))
+			for _, host := range aggregate.Hosts {
+				filter.Hosts[host] = true
+			}
+		}
+
+		if filter.Hosts == nil {
+			return fmt.Errorf("Could not find any host aggregate with this name: %s", name)
+		}
+	}
+
+	var listOpts hypervisors.ListOpts
+	if v := d.Get("hostname_pattern").(string); v != "" {
+		supported, err := computeV2MicroversionSupported(config, computeClient, computeHypervisorsV2HostnamePatternMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		// Older Compute APIs can't filter by hostname, filter client-side then.
+		if supported {
+			listOpts.HypervisorHostnamePattern = &v
+			computeClient.Microversion = computeHypervisorsV2HostnamePatternMicroversion
+		} else {
+			filter.HostnamePattern = v
+		}
+	}
+
+	log.Printf("[DEBUG] openstack_compute_hypervisors_v2 list options: %#v", listOpts)
+
+	allPages, err := hypervisors.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error listing compute hypervisors: %s", err)
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisors(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting compute hypervisors: %s", err)
+	}
+
+	var refinedHypervisors []hypervisors.Hypervisor
+	var ids []string
+	for _, hypervisor := range allHypervisors {
+		if !filter.match(hypervisor) {
+			continue
+		}
+
+		refinedHypervisors = append(refinedHypervisors, hypervisor)
+		ids = append(ids, hypervisor.ID)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d hypervisors in openstack_compute_hypervisors_v2", len(refinedHypervisors))
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	d.Set("region", region)
+
+	if err := d.Set("hypervisors", flattenComputeHypervisorsV2(refinedHypervisors)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_hypervisors_v2 hypervisors: %s", err)
+	}
+
+	for k, v := range computeHypervisorsV2Totals(refinedHypervisors) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
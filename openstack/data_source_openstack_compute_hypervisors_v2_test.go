@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeHypervisorsV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckHypervisor(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHypervisorsV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_hypervisors_v2.hypervisors", "hypervisors.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_hypervisors_v2.hypervisors", "hypervisors.0.hostname", osHypervisorEnvironment),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_hypervisors_v2.hypervisors", "total_vcpus",
+						"data.openstack_compute_hypervisors_v2.hypervisors", "hypervisors.0.vcpus"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_hypervisors_v2.hypervisors", "total_memory_free"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeHypervisorsV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_compute_hypervisors_v2" "hypervisors" {
+  hostname_pattern = "%s"
+  state            = "up"
+  status           = "enabled"
+}
+`, osHypervisorEnvironment)
+}
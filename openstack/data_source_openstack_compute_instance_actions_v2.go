@@ -0,0 +1,205 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeInstanceActionsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceActionsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"changes_since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"include_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// computed-only
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"result": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"host": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"traceback": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"finish_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceActionsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	includeEvents := d.Get("include_events").(bool)
+
+	// Events are visible to non-admin users since microversion 2.51.
+	if includeEvents {
+		supported, err := computeV2MicroversionSupported(config, computeClient, computeV2InstanceActionsEventsMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if supported {
+			computeClient.Microversion = computeV2InstanceActionsEventsMicroversion
+		}
+	}
+
+	var listOpts instanceactions.ListOpts
+	if v, ok := d.GetOk("changes_since"); ok {
+		changesSince, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing changes_since for openstack_compute_instance_actions_v2: %s", err)
+		}
+
+		supported, err := computeV2MicroversionSupported(config, computeClient, computeV2InstanceActionsChangesSinceMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Filtering openstack_compute_instance_actions_v2 by changes_since requires microversion %s, "+
+				"which isn't supported by the compute API", computeV2InstanceActionsChangesSinceMicroversion)
+		}
+
+		listOpts.ChangesSince = &changesSince
+		computeClient.Microversion = computeV2InstanceActionsChangesSinceMicroversion
+	}
+
+	allPages, err := instanceactions.List(computeClient, instanceID, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list openstack_compute_instance_actions_v2 for instance %s: %s", instanceID, err)
+	}
+
+	allActions, err := instanceactions.ExtractInstanceActions(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_compute_instance_actions_v2 for instance %s: %s", instanceID, err)
+	}
+
+	actionFilter := d.Get("action").(string)
+	actions := []map[string]interface{}{}
+	for _, a := range allActions {
+		if actionFilter != "" && a.Action != actionFilter {
+			continue
+		}
+
+		// The events are only returned by the action details.
+		action := instanceactions.InstanceActionDetail{
+			Action:    a.Action,
+			RequestID: a.RequestID,
+			UserID:    a.UserID,
+			ProjectID: a.ProjectID,
+			Message:   a.Message,
+			StartTime: a.StartTime,
+		}
+
+		if includeEvents {
+			action, err = instanceactions.Get(computeClient, instanceID, a.RequestID).Extract()
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve openstack_compute_instance_actions_v2 action %s for instance %s: %s", a.RequestID, instanceID, err)
+			}
+		}
+
+		actions = append(actions, flattenComputeV2InstanceAction(action))
+	}
+
+	log.Printf("[DEBUG] Retrieved %d actions in openstack_compute_instance_actions_v2 for instance %s", len(actions), instanceID)
+
+	d.SetId(instanceID)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("actions", actions); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_instance_actions_v2 actions: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2InstanceActionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceActionsDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_actions_v2.actions", "id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_actions_v2.actions", "actions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_actions_v2.actions", "actions.0.action", "create"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_instance_actions_v2.actions", "actions.0.request_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_instance_actions_v2.actions", "actions.0.events.0.event"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2InstanceActionsDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instance_actions_v2" "actions" {
+  instance_id    = "${openstack_compute_instance_v2.instance_1.id}"
+  action         = "create"
+  include_events = true
+}
+`, osNetworkID)
+}
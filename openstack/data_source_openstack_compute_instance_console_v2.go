@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeInstanceConsoleV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceConsoleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"console_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(computeV2InstanceConsoleTypes(), false),
+			},
+
+			// computed-only
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+
+	consoleOutputOpts := servers.ShowConsoleOutputOpts{
+		Length: d.Get("length").(int),
+	}
+
+	output, err := servers.ShowConsoleOutput(computeClient, instanceID, consoleOutputOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_instance_console_v2 output for instance %s: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_instance_console_v2 output for instance %s", instanceID)
+
+	d.Set("output", output)
+	d.Set("region", GetRegion(d, config))
+
+	consoleType := d.Get("console_type").(string)
+	if consoleType == "" {
+		d.Set("url", "")
+		return nil
+	}
+
+	createOpts, err := expandComputeV2InstanceRemoteConsoleCreateOpts(consoleType)
+	if err != nil {
+		return err
+	}
+
+	microversion := computeV2InstanceRemoteConsoleMicroversionFor(createOpts.Protocol)
+	supported, err := computeV2MicroversionSupported(config, computeClient, microversion)
+	if err != nil {
+		return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+	}
+
+	if !supported {
+		return fmt.Errorf("Creating a %s console for openstack_compute_instance_console_v2 requires microversion %s, "+
+			"which isn't supported by the compute API", consoleType, microversion)
+	}
+
+	computeClient.Microversion = microversion
+	console, err := remoteconsoles.Create(computeClient, instanceID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_compute_instance_console_v2 %s console for instance %s: %s", consoleType, instanceID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_compute_instance_console_v2 %s console for instance %s", consoleType, instanceID)
+
+	d.Set("url", console.URL)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2InstanceConsoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceConsoleDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_console_v2.console", "id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_instance_console_v2.console", "output"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_instance_console_v2.console", "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2InstanceConsoleDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instance_console_v2" "console" {
+  instance_id  = "${openstack_compute_instance_v2.instance_1.id}"
+  length       = 10
+  console_type = "novnc"
+}
+`, osNetworkID)
+}
@@ -0,0 +1,256 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeInstancesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstancesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"all_tenants": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags_any": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_pair": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"access_ip_v4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_ip_v6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"fixed_ip_v4": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"fixed_ip_v6": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstancesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	listOpts := servers.ListOpts{
+		Name:             d.Get("name").(string),
+		Status:           strings.ToUpper(d.Get("status").(string)),
+		Flavor:           d.Get("flavor_id").(string),
+		Image:            d.Get("image_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		TenantID:         d.Get("tenant_id").(string),
+		AllTenants:       d.Get("all_tenants").(bool),
+	}
+
+	// The tags of the servers are only returned with the tags microversion.
+	tagsSupported, err := computeV2MicroversionSupported(config, computeClient, computeV2TagsExtensionMicroversion)
+	if err != nil {
+		return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+	}
+
+	if tagsSupported {
+		computeClient.Microversion = computeV2TagsExtensionMicroversion
+	}
+
+	tags := expandToStringSlice(d.Get("tags").(*schema.Set).List())
+	tagsAny := expandToStringSlice(d.Get("tags_any").(*schema.Set).List())
+	if len(tags) > 0 || len(tagsAny) > 0 {
+		if !tagsSupported {
+			return fmt.Errorf("Filtering openstack_compute_instances_v2 by tags requires microversion %s, "+
+				"which isn't supported by the compute API", computeV2TagsExtensionMicroversion)
+		}
+
+		listOpts.Tags = strings.Join(tags, ",")
+		listOpts.TagsAny = strings.Join(tagsAny, ",")
+	}
+
+	log.Printf("[DEBUG] openstack_compute_instances_v2 list options: %#v", listOpts)
+
+	allPages, err := servers.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list openstack_compute_instances_v2: %s", err)
+	}
+
+	var allServers []computeInstancesV2Server
+	err = servers.ExtractServersInto(allPages, &allServers)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_compute_instances_v2: %s", err)
+	}
+
+	// The servers API does not support filtering by metadata.
+	metadata := d.Get("metadata").(map[string]interface{})
+	var filteredServers []computeInstancesV2Server
+	var ids []string
+	for _, server := range allServers {
+		if !computeInstancesV2MetadataMatch(server.Metadata, metadata) {
+			continue
+		}
+
+		filteredServers = append(filteredServers, server)
+		ids = append(ids, server.ID)
+	}
+
+	if len(filteredServers) == 0 {
+		log.Printf("[DEBUG] No instances in openstack_compute_instances_v2 found")
+	}
+
+	log.Printf("[DEBUG] Retrieved %d instances in openstack_compute_instances_v2", len(filteredServers))
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("instances", flattenComputeInstancesV2(filteredServers)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_instances_v2 instances: %s", err)
+	}
+
+	return nil
+}
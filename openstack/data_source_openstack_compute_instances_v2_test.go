@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2InstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstancesDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstack_compute_instances_v2.instances", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instances_v2.instances", "ids.0",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instances_v2.instances", "instances.0.name", "instance_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instances_v2.instances", "instances.0.metadata.role", "web"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instances_v2.instances", "instances.0.network.0.fixed_ip_v4",
+						"openstack_compute_instance_v2.instance_1", "network.0.fixed_ip_v4"),
+					resource.TestCheckResourceAttr("data.openstack_compute_instances_v2.tags", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instances_v2.tags", "ids.0",
+						"openstack_compute_instance_v2.instance_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2InstancesDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  metadata = {
+    role = "web"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_2" {
+  name            = "instance_2"
+  security_groups = ["default"]
+  tags            = ["tf-acc-instances"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_instances_v2" "instances" {
+  name = "^instance_1$"
+  metadata = {
+    role = "web"
+  }
+
+  depends_on = ["openstack_compute_instance_v2.instance_1"]
+}
+
+data "openstack_compute_instances_v2" "tags" {
+  tags = ["tf-acc-instances"]
+
+  depends_on = ["openstack_compute_instance_v2.instance_2"]
+}
+`, osNetworkID, osNetworkID)
+}
@@ -46,7 +46,7 @@ func dataSourceComputeKeypairV2Read(d *schema.ResourceData, meta interface{}) er
 	}
 
 	name := d.Get("name").(string)
-	kp, err := keypairs.Get(computeClient, name).Extract()
+	kp, err := keypairs.Get(computeClient, name, nil).Extract()
 	if err != nil {
 		return fmt.Errorf("Error retrieving openstack_compute_keypair_v2 %s: %s", name, err)
 	}
@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeLimitsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeLimitsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// computed-only
+			"max_total_cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_image_meta": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_server_meta": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_personality": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_personality_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_total_keypairs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_security_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_security_group_rules": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_server_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_server_group_members": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_total_floating_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_total_instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_total_ram_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_cores_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_instances_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_floating_ips_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_ram_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_security_groups_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_server_groups_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeLimitsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	// Default to the project of the current token.
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		_, projectID, err = GetTokenInfo(computeClient)
+		if err != nil {
+			return fmt.Errorf("Error getting the project of the current token for openstack_compute_limits_v2: %s", err)
+		}
+	}
+
+	getOpts := limits.GetOpts{
+		TenantID: projectID,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_limits_v2 get options: %#v", getOpts)
+
+	l, err := limits.Get(computeClient, getOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_limits_v2 for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_limits_v2 for project %s: %#v", projectID, l)
+
+	d.SetId(projectID)
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+
+	for k, v := range flattenComputeLimitsV2Absolute(l.Absolute) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2LimitsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2LimitsDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_limits_v2.limits", "project_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_limits_v2.limits", "max_total_cores"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_limits_v2.limits", "total_instances_used", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2LimitsDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_limits_v2" "limits" {
+  depends_on = ["openstack_compute_instance_v2.instance_1"]
+}
+`, osNetworkID)
+}
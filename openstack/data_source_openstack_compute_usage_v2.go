@@ -0,0 +1,200 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/usage"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeUsageV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeUsageV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"start": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// computed-only
+			"total_hours": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"total_local_gb_usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"total_memory_mb_usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"total_vcpus_usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"server_usages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hours": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"local_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ended_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeUsageV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	// Default to the project of the current token.
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		_, projectID, err = GetTokenInfo(computeClient)
+		if err != nil {
+			return fmt.Errorf("Error getting the project of the current token for openstack_compute_usage_v2: %s", err)
+		}
+	}
+
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing start for openstack_compute_usage_v2: %s", err)
+	}
+
+	// The usage window ends now, unless an end is specified.
+	end := time.Now().UTC()
+	if v, ok := d.GetOk("end"); ok {
+		end, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing end for openstack_compute_usage_v2: %s", err)
+		}
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("The end of openstack_compute_usage_v2 must be after its start")
+	}
+
+	start, end = start.UTC(), end.UTC()
+	opts := usage.SingleTenantOpts{
+		Start: &start,
+		End:   &end,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_usage_v2 options for project %s: %#v", projectID, opts)
+
+	// Each page carries the totals of its own servers.
+	var totals computeUsageV2Totals
+	var serverUsages []usage.ServerUsage
+	err = usage.SingleTenant(computeClient, projectID, opts).EachPage(func(page pagination.Page) (bool, error) {
+		tenantUsage, err := usage.ExtractSingleTenant(page)
+		if err != nil {
+			return false, err
+		}
+
+		if tenantUsage != nil {
+			totals.add(tenantUsage)
+			serverUsages = append(serverUsages, tenantUsage.ServerUsages...)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_usage_v2 for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_usage_v2 for project %s: %#v", projectID, totals)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", projectID, start.Format(time.RFC3339), end.Format(time.RFC3339)))
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("end", end.Format(time.RFC3339))
+	d.Set("total_hours", totals.TotalHours)
+	d.Set("total_local_gb_usage", totals.TotalLocalGBUsage)
+	d.Set("total_memory_mb_usage", totals.TotalMemoryMBUsage)
+	d.Set("total_vcpus_usage", totals.TotalVCPUsUsage)
+
+	if err := d.Set("server_usages", flattenComputeUsageV2ServerUsages(serverUsages)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_usage_v2 server_usages: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2UsageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2UsageDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_usage_v2.usage", "project_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_usage_v2.usage", "end"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_usage_v2.usage", "total_hours"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_usage_v2.usage", "server_usages.0.instance_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2UsageDataSourceBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+data "openstack_compute_usage_v2" "usage" {
+  start      = "2020-01-01T00:00:00Z"
+  depends_on = ["openstack_compute_instance_v2.instance_1"]
+}
+`, osNetworkID)
+}
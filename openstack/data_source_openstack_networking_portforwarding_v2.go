@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceNetworkingPortForwardingV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingPortForwardingV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"portforwarding_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"internal_port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"internal_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"internal_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"external_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingPortForwardingV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := portforwarding.ListOpts{
+		ID:                d.Get("portforwarding_id").(string),
+		InternalPortID:    d.Get("internal_port_id").(string),
+		InternalIPAddress: d.Get("internal_ip_address").(string),
+		Protocol:          d.Get("protocol").(string),
+	}
+
+	if v, ok := d.GetOk("internal_port"); ok {
+		listOpts.InternalPort = strconv.Itoa(v.(int))
+	}
+
+	if v, ok := d.GetOk("external_port"); ok {
+		listOpts.ExternalPort = strconv.Itoa(v.(int))
+	}
+
+	fipID := d.Get("floatingip_id").(string)
+	pages, err := portforwarding.List(networkingClient, listOpts, fipID).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list openstack_networking_portforwarding_v2 of floating IP %s: %s", fipID, err)
+	}
+
+	allPortForwardings, err := extractNetworkingPortForwardingsV2(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_networking_portforwarding_v2 of floating IP %s: %s", fipID, err)
+	}
+
+	// The API does not support filtering by description.
+	var refinedPortForwardings []networkingPortForwardingV2
+	description := d.Get("description").(string)
+	for _, pf := range allPortForwardings {
+		if description != "" && pf.Description != description {
+			continue
+		}
+		refinedPortForwardings = append(refinedPortForwardings, pf)
+	}
+
+	if len(refinedPortForwardings) < 1 {
+		return fmt.Errorf("No openstack_networking_portforwarding_v2 found")
+	}
+
+	if len(refinedPortForwardings) > 1 {
+		return fmt.Errorf("More than one openstack_networking_portforwarding_v2 found")
+	}
+
+	pf := refinedPortForwardings[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_portforwarding_v2 %s: %+v", pf.ID, pf)
+	d.SetId(pf.ID)
+
+	d.Set("portforwarding_id", pf.ID)
+	d.Set("internal_port_id", pf.InternalPortID)
+	d.Set("internal_ip_address", pf.InternalIPAddress)
+	d.Set("internal_port", pf.InternalPort)
+	d.Set("external_port", pf.ExternalPort)
+	d.Set("protocol", pf.Protocol)
+	d.Set("description", pf.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
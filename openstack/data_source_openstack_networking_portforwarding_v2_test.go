@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNetworkingV2PortForwardingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortForwardingDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_portforwarding_v2.pf_1", "id",
+						"openstack_networking_portforwarding_v2.pf_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_portforwarding_v2.pf_1", "internal_port", "22"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_portforwarding_v2.pf_1", "description", "ssh"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2PortForwardingDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_portforwarding_v2" "pf_1" {
+  floatingip_id = "${openstack_networking_portforwarding_v2.pf_1.floatingip_id}"
+  external_port = "${openstack_networking_portforwarding_v2.pf_1.external_port}"
+}
+`, testAccNetworkingV2PortForwardingBasic(2222, "ssh"))
+}
@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3Backup_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_backup_v3.backup_1"
+	backupName := acctest.RandomWithPrefix("tf-acc-backup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic(backupName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force",
+				},
+			},
+		},
+	})
+}
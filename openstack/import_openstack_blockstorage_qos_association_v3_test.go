@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3QoSAssociation_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_qos_association_v3.qos_association_1"
+
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+	volumeTypeName := acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSAssociationBasic(qosName, volumeTypeName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
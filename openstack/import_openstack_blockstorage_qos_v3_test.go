@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3QoS_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_qos_v3.qos_1"
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSBasic(qosName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3Snapshot_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_snapshot_v3.snapshot_1"
+	snapshotName := acctest.RandomWithPrefix("tf-acc-snapshot")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3SnapshotBasic(snapshotName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageVolumeAttachV3_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_volume_attach_v3.va_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageVolumeAttachV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVolumeAttachV3Basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"data",
+					"device",
+					"driver_volume_type",
+					"initiator",
+					"ip_address",
+					"mount_point_base",
+					"os_type",
+					"platform",
+				},
+			},
+		},
+	})
+}
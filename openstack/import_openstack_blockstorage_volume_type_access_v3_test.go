@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3VolumeTypeAccess_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_volume_type_access_v3.access_1"
+
+	volumeTypeName := acctest.RandomWithPrefix("tf-acc-volume-type")
+	projectName := acctest.RandomWithPrefix("tf-acc-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeAccessBasic(volumeTypeName, projectName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeV2Service_importBasic(t *testing.T) {
+	resourceName := "openstack_compute_service_v2.service_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckHypervisor(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ServiceBasic("disabled", "maintenance"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
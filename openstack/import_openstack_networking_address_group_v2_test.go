@@ -0,0 +1,27 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNetworkingV2AddressGroup_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_address_group_v2.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AddressGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2AddressGroupBasic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
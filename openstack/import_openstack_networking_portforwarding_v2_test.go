@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccNetworkingV2PortForwarding_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_portforwarding_v2.pf_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortForwardingBasic(2222, "ssh"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNetworkingV2PortForwardingImportID(resourceName),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2PortForwardingImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["floatingip_id"], rs.Primary.ID), nil
+	}
+}
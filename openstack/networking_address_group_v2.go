@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// networkingAddressGroupV2 represents an address group. gophercloud doesn't
+// implement the address groups extension, so the requests are issued
+// directly.
+type networkingAddressGroupV2 struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	ProjectID   string   `json:"project_id"`
+	Addresses   []string `json:"addresses"`
+}
+
+// networkingAddressGroupV2CreateOpts represents the body of an address group
+// creation.
+type networkingAddressGroupV2CreateOpts struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ProjectID   string   `json:"project_id,omitempty"`
+	Addresses   []string `json:"addresses"`
+}
+
+// networkingAddressGroupV2UpdateOpts represents the body of an address group
+// update. Addresses are updated with networkingAddressGroupV2AddAddresses and
+// networkingAddressGroupV2RemoveAddresses.
+type networkingAddressGroupV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// networkingAddressGroupV2AddressesOpts represents the body of an addition
+// or a removal of addresses.
+type networkingAddressGroupV2AddressesOpts struct {
+	Addresses []string `json:"addresses" required:"true"`
+}
+
+// networkingAddressGroupV2Result represents the response of a single address
+// group.
+type networkingAddressGroupV2Result struct {
+	AddressGroup networkingAddressGroupV2 `json:"address_group"`
+}
+
+// networkingAddressGroupV2Create creates a new address group.
+func networkingAddressGroupV2Create(client *gophercloud.ServiceClient, opts networkingAddressGroupV2CreateOpts) (*networkingAddressGroupV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return nil, err
+	}
+
+	var r networkingAddressGroupV2Result
+	_, err = client.Post(client.ServiceURL("address-groups"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AddressGroup, nil
+}
+
+// networkingAddressGroupV2Get returns the address group with the given ID.
+func networkingAddressGroupV2Get(client *gophercloud.ServiceClient, id string) (*networkingAddressGroupV2, error) {
+	var r networkingAddressGroupV2Result
+	_, err := client.Get(client.ServiceURL("address-groups", id), &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AddressGroup, nil
+}
+
+// networkingAddressGroupV2Update updates the address group with the given ID.
+func networkingAddressGroupV2Update(client *gophercloud.ServiceClient, id string, opts networkingAddressGroupV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("address-groups", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// networkingAddressGroupV2AddAddresses adds addresses to the address group
+// with the given ID.
+func networkingAddressGroupV2AddAddresses(client *gophercloud.ServiceClient, id string, addresses []string) error {
+	return networkingAddressGroupV2UpdateAddresses(client, id, "add_addresses", addresses)
+}
+
+// networkingAddressGroupV2RemoveAddresses removes addresses from the address
+// group with the given ID.
+func networkingAddressGroupV2RemoveAddresses(client *gophercloud.ServiceClient, id string, addresses []string) error {
+	return networkingAddressGroupV2UpdateAddresses(client, id, "remove_addresses", addresses)
+}
+
+func networkingAddressGroupV2UpdateAddresses(client *gophercloud.ServiceClient, id, action string, addresses []string) error {
+	b, err := gophercloud.BuildRequestBody(networkingAddressGroupV2AddressesOpts{Addresses: addresses}, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("address-groups", id, action), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// networkingAddressGroupV2Delete deletes the address group with the given ID.
+func networkingAddressGroupV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("address-groups", id), nil)
+
+	return err
+}
@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingAddressGroupV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "address_group": {
+    "name": "partners",
+    "addresses": []
+  }
+}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+{
+  "address_group": {
+    "id": "address_group_1",
+    "name": "partners",
+    "description": "",
+    "project_id": "project_1",
+    "addresses": []
+  }
+}`)
+	})
+
+	opts := networkingAddressGroupV2CreateOpts{
+		Name:      "partners",
+		Addresses: []string{},
+	}
+
+	expected := &networkingAddressGroupV2{
+		ID:        "address_group_1",
+		Name:      "partners",
+		ProjectID: "project_1",
+		Addresses: []string{},
+	}
+
+	actual, err := networkingAddressGroupV2Create(thclient.ServiceClient(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingAddressGroupV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/address-groups/address_group_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "address_group": {
+    "id": "address_group_1",
+    "name": "partners",
+    "description": "partner networks",
+    "project_id": "project_1",
+    "addresses": ["192.0.2.0/24", "2001:db8::/64"]
+  }
+}`)
+	})
+
+	expected := &networkingAddressGroupV2{
+		ID:          "address_group_1",
+		Name:        "partners",
+		Description: "partner networks",
+		ProjectID:   "project_1",
+		Addresses:   []string{"192.0.2.0/24", "2001:db8::/64"},
+	}
+
+	actual, err := networkingAddressGroupV2Get(thclient.ServiceClient(), "address_group_1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingAddressGroupV2UpdateAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/address-groups/address_group_1/add_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"addresses": ["198.51.100.0/24"]}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address_group": {"id": "address_group_1"}}`)
+	})
+
+	th.Mux.HandleFunc("/address-groups/address_group_1/remove_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"addresses": ["192.0.2.0/24"]}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"address_group": {"id": "address_group_1"}}`)
+	})
+
+	client := thclient.ServiceClient()
+
+	err := networkingAddressGroupV2AddAddresses(client, "address_group_1", []string{"198.51.100.0/24"})
+	assert.NoError(t, err)
+
+	err = networkingAddressGroupV2RemoveAddresses(client, "address_group_1", []string{"192.0.2.0/24"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// networkingPortForwardingV2 is a portforwarding.PortForwarding with the
+// description, which is not part of gophercloud.
+type networkingPortForwardingV2 struct {
+	portforwarding.PortForwarding
+	Description string `json:"description"`
+}
+
+// networkingPortForwardingV2CreateOpts represents the attributes used when
+// creating a new port forwarding.
+type networkingPortForwardingV2CreateOpts struct {
+	portforwarding.CreateOpts
+	Description string `json:"description,omitempty"`
+}
+
+// ToPortForwardingCreateMap casts a CreateOpts struct to a map.
+// It overrides portforwarding.ToPortForwardingCreateMap to add the Description field.
+func (opts networkingPortForwardingV2CreateOpts) ToPortForwardingCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "port_forwarding")
+}
+
+// networkingPortForwardingV2UpdateOpts represents the attributes used when
+// updating an existing port forwarding.
+type networkingPortForwardingV2UpdateOpts struct {
+	portforwarding.UpdateOpts
+	Description *string `json:"description,omitempty"`
+}
+
+// ToPortForwardingUpdateMap casts an UpdateOpts struct to a map.
+// It overrides portforwarding.ToPortForwardingUpdateMap to add the Description field.
+func (opts networkingPortForwardingV2UpdateOpts) ToPortForwardingUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "port_forwarding")
+}
+
+// extractNetworkingPortForwardingsV2 extracts the port forwardings of a page
+// including their description.
+func extractNetworkingPortForwardingsV2(page pagination.Page) ([]networkingPortForwardingV2, error) {
+	var s struct {
+		PortForwardings []networkingPortForwardingV2 `json:"port_forwardings"`
+	}
+	err := page.(portforwarding.PortForwardingPage).ExtractInto(&s)
+	return s.PortForwardings, err
+}
@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingPortForwardingV2CreateOpts(t *testing.T) {
+	opts := networkingPortForwardingV2CreateOpts{
+		CreateOpts: portforwarding.CreateOpts{
+			InternalPortID:    "port_1",
+			InternalIPAddress: "192.168.199.10",
+			InternalPort:      22,
+			ExternalPort:      2222,
+			Protocol:          "tcp",
+		},
+		Description: "ssh",
+	}
+
+	expected := map[string]interface{}{
+		"port_forwarding": map[string]interface{}{
+			"internal_port_id":    "port_1",
+			"internal_ip_address": "192.168.199.10",
+			"internal_port":       float64(22),
+			"external_port":       float64(2222),
+			"protocol":            "tcp",
+			"description":         "ssh",
+		},
+	}
+
+	actual, err := opts.ToPortForwardingCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingPortForwardingV2UpdateOpts(t *testing.T) {
+	description := ""
+	opts := networkingPortForwardingV2UpdateOpts{
+		UpdateOpts: portforwarding.UpdateOpts{
+			ExternalPort: 2223,
+		},
+		Description: &description,
+	}
+
+	expected := map[string]interface{}{
+		"port_forwarding": map[string]interface{}{
+			"external_port": float64(2223),
+			"description":   "",
+		},
+	}
+
+	actual, err := opts.ToPortForwardingUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExtractNetworkingPortForwardingsV2(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/floatingips/fip_1/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "port_forwardings": [
+    {
+      "id": "pf_1",
+      "internal_port_id": "port_1",
+      "internal_ip_address": "192.168.199.10",
+      "internal_port": 22,
+      "external_port": 2222,
+      "protocol": "tcp",
+      "description": "ssh"
+    }
+  ]
+}`)
+	})
+
+	client := thclient.ServiceClient()
+	pages, err := portforwarding.List(client, nil, "fip_1").AllPages()
+	assert.NoError(t, err)
+
+	actual, err := extractNetworkingPortForwardingsV2(pages)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "pf_1", actual[0].ID)
+	assert.Equal(t, 2222, actual[0].ExternalPort)
+	assert.Equal(t, "ssh", actual[0].Description)
+}
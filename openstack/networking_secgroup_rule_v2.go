@@ -9,6 +9,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
+// networkingSecGroupRuleV2 represents a security group rule with its remote
+// address group, which isn't supported by gophercloud.
+type networkingSecGroupRuleV2 struct {
+	rules.SecGroupRule
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+}
+
+// networkingSecGroupRuleV2CreateOpts represents the attributes used when
+// creating a security group rule with a remote address group.
+type networkingSecGroupRuleV2CreateOpts struct {
+	rules.CreateOpts
+	RemoteAddressGroupID string `json:"remote_address_group_id,omitempty"`
+}
+
+// ToSecGroupRuleCreateMap casts a CreateOpts struct to a map.
+// It overrides rules.ToSecGroupRuleCreateMap to add the RemoteAddressGroupID
+// field.
+func (opts networkingSecGroupRuleV2CreateOpts) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "security_group_rule")
+}
+
 func resourceNetworkingSecGroupRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, sgRuleID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		sgRule, err := rules.Get(client, sgRuleID).Extract()
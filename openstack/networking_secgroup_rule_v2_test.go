@@ -98,3 +98,27 @@ func TestResourceNetworkingSecGroupRuleV2ProtocolNumber(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestNetworkingSecGroupRuleV2CreateOpts(t *testing.T) {
+	opts := networkingSecGroupRuleV2CreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Direction:  rules.DirIngress,
+			EtherType:  rules.EtherType4,
+			SecGroupID: "sg_1",
+		},
+		RemoteAddressGroupID: "address_group_1",
+	}
+
+	expected := map[string]interface{}{
+		"security_group_rule": map[string]interface{}{
+			"direction":               "ingress",
+			"ethertype":               "IPv4",
+			"security_group_id":       "sg_1",
+			"remote_address_group_id": "address_group_1",
+		},
+	}
+
+	actual, err := opts.ToSecGroupRuleCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
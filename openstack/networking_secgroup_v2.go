@@ -1,12 +1,18 @@
 package openstack
 
 import (
+	"bytes"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 )
 
 // networkingSecgroupV2StateRefreshFuncDelete returns a special case resource.StateRefreshFunc to try to delete a secgroup.
@@ -42,3 +48,200 @@ func networkingSecgroupV2StateRefreshFuncDelete(networkingClient *gophercloud.Se
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingSecGroupV2RulesCheckForErrors validates the inline rules of a
+// security group.
+func networkingSecGroupV2RulesCheckForErrors(rawRules []interface{}) error {
+	for _, rawRule := range rawRules {
+		rawRuleMap := rawRule.(map[string]interface{})
+
+		// only one of remote_ip_prefix, remote_group_id, remote_address_group_id,
+		// or self can be set
+		var remotes int
+		if rawRuleMap["remote_ip_prefix"].(string) != "" {
+			remotes++
+		}
+		if rawRuleMap["remote_group_id"].(string) != "" {
+			remotes++
+		}
+		if rawRuleMap["remote_address_group_id"].(string) != "" {
+			remotes++
+		}
+		if rawRuleMap["self"].(bool) {
+			remotes++
+		}
+		if remotes > 1 {
+			return fmt.Errorf("Only one of remote_ip_prefix, remote_group_id, remote_address_group_id, or self can be set")
+		}
+
+		if rawRuleMap["protocol"].(string) == "" {
+			if rawRuleMap["port_range_min"].(int) != 0 || rawRuleMap["port_range_max"].(int) != 0 {
+				return fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max")
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandNetworkingSecGroupV2Rule(secGroupID string, rawRule interface{}) (networkingSecGroupRuleV2CreateOpts, error) {
+	rawRuleMap := rawRule.(map[string]interface{})
+
+	remoteGroupID := rawRuleMap["remote_group_id"].(string)
+	if rawRuleMap["self"].(bool) {
+		remoteGroupID = secGroupID
+	}
+
+	opts := networkingSecGroupRuleV2CreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Description:    rawRuleMap["description"].(string),
+			SecGroupID:     secGroupID,
+			PortRangeMin:   rawRuleMap["port_range_min"].(int),
+			PortRangeMax:   rawRuleMap["port_range_max"].(int),
+			RemoteGroupID:  remoteGroupID,
+			RemoteIPPrefix: rawRuleMap["remote_ip_prefix"].(string),
+		},
+		RemoteAddressGroupID: rawRuleMap["remote_address_group_id"].(string),
+	}
+
+	direction, err := resourceNetworkingSecGroupRuleV2Direction(rawRuleMap["direction"].(string))
+	if err != nil {
+		return opts, err
+	}
+	opts.Direction = direction
+
+	etherType, err := resourceNetworkingSecGroupRuleV2EtherType(rawRuleMap["ethertype"].(string))
+	if err != nil {
+		return opts, err
+	}
+	opts.EtherType = etherType
+
+	if v := rawRuleMap["protocol"].(string); v != "" {
+		protocol, err := resourceNetworkingSecGroupRuleV2Protocol(v)
+		if err != nil {
+			return opts, err
+		}
+		opts.Protocol = protocol
+	}
+
+	return opts, nil
+}
+
+// networkingSecGroupV2ExtractRules extracts the rules of a security group
+// including their remote address groups.
+func networkingSecGroupV2ExtractRules(r groups.GetResult) ([]networkingSecGroupRuleV2, error) {
+	var s struct {
+		Rules []networkingSecGroupRuleV2 `json:"security_group_rules"`
+	}
+
+	err := r.ExtractIntoStructPtr(&s, "security_group")
+
+	return s.Rules, err
+}
+
+func flattenNetworkingSecGroupV2Rules(secGroupID string, sgRules []networkingSecGroupRuleV2) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(sgRules))
+
+	for i, sgRule := range sgRules {
+		remoteGroupID := sgRule.RemoteGroupID
+		self := false
+		if remoteGroupID == secGroupID {
+			remoteGroupID = ""
+			self = true
+		}
+
+		result[i] = map[string]interface{}{
+			"id":                      sgRule.ID,
+			"description":             sgRule.Description,
+			"direction":               sgRule.Direction,
+			"ethertype":               sgRule.EtherType,
+			"protocol":                sgRule.Protocol,
+			"port_range_min":          sgRule.PortRangeMin,
+			"port_range_max":          sgRule.PortRangeMax,
+			"remote_ip_prefix":        strings.ToLower(sgRule.RemoteIPPrefix),
+			"remote_group_id":         remoteGroupID,
+			"remote_address_group_id": sgRule.RemoteAddressGroupID,
+			"self":                    self,
+		}
+	}
+
+	return result
+}
+
+func networkingSecGroupV2RuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["direction"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ethertype"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["protocol"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_min"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_max"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["remote_ip_prefix"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_address_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["self"].(bool)))
+	buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+// networkingSecGroupV2UpdateRules deletes the rules, which are only in
+// oldRules, and creates the rules, which are only in newRules. Rules are
+// deleted first, so that a changed rule doesn't conflict with its old version.
+func networkingSecGroupV2UpdateRules(networkingClient *gophercloud.ServiceClient, secGroupID string, oldRules, newRules *schema.Set) error {
+	rulesToRemove := oldRules.Difference(newRules)
+	rulesToAdd := newRules.Difference(oldRules)
+
+	log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s rules to add: %v", secGroupID, rulesToAdd)
+	log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s rules to remove: %v", secGroupID, rulesToRemove)
+
+	for _, rawRule := range rulesToRemove.List() {
+		ruleID := rawRule.(map[string]interface{})["id"].(string)
+		if ruleID == "" {
+			continue
+		}
+
+		err := rules.Delete(networkingClient, ruleID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+
+			return fmt.Errorf("Error removing rule %s from openstack_networking_secgroup_v2 %s: %s", ruleID, secGroupID, err)
+		}
+	}
+
+	for _, rawRule := range rulesToAdd.List() {
+		opts, err := expandNetworkingSecGroupV2Rule(secGroupID, rawRule)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s rule create options: %#v", secGroupID, opts)
+		_, err = rules.Create(networkingClient, opts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error adding rule to openstack_networking_secgroup_v2 %s: %s", secGroupID, err)
+		}
+	}
+
+	return nil
+}
+
+// networkingSecGroupV2CreateRules reconciles the rules of a new security
+// group with its inline rules.
+func networkingSecGroupV2CreateRules(networkingClient *gophercloud.ServiceClient, config *Config, secGroupID string, newRules *schema.Set) error {
+	config.MutexKV.Lock(secGroupID)
+	defer config.MutexKV.Unlock(secGroupID)
+
+	sgRules, err := networkingSecGroupV2ExtractRules(groups.Get(networkingClient, secGroupID))
+	if err != nil {
+		return fmt.Errorf("Error retrieving the created openstack_networking_secgroup_v2 %s: %s", secGroupID, err)
+	}
+
+	oldRules := schema.NewSet(networkingSecGroupV2RuleHash, nil)
+	for _, rule := range flattenNetworkingSecGroupV2Rules(secGroupID, sgRules) {
+		oldRules.Add(rule)
+	}
+
+	return networkingSecGroupV2UpdateRules(networkingClient, secGroupID, oldRules, newRules)
+}
@@ -0,0 +1,179 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNetworkingSecGroupV2Rule(id, remoteIPPrefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                      id,
+		"description":             "",
+		"direction":               "ingress",
+		"ethertype":               "IPv4",
+		"protocol":                "tcp",
+		"port_range_min":          22,
+		"port_range_max":          22,
+		"remote_ip_prefix":        remoteIPPrefix,
+		"remote_group_id":         "",
+		"remote_address_group_id": "",
+		"self":                    false,
+	}
+}
+
+func TestNetworkingSecGroupV2RulesCheckForErrors(t *testing.T) {
+	valid := testNetworkingSecGroupV2Rule("", "10.0.0.0/8")
+	assert.NoError(t, networkingSecGroupV2RulesCheckForErrors([]interface{}{valid}))
+
+	twoRemotes := testNetworkingSecGroupV2Rule("", "10.0.0.0/8")
+	twoRemotes["self"] = true
+	assert.Error(t, networkingSecGroupV2RulesCheckForErrors([]interface{}{twoRemotes}))
+
+	addressGroup := testNetworkingSecGroupV2Rule("", "10.0.0.0/8")
+	addressGroup["remote_address_group_id"] = "ag_1"
+	assert.Error(t, networkingSecGroupV2RulesCheckForErrors([]interface{}{addressGroup}))
+
+	noProtocol := testNetworkingSecGroupV2Rule("", "10.0.0.0/8")
+	noProtocol["protocol"] = ""
+	assert.Error(t, networkingSecGroupV2RulesCheckForErrors([]interface{}{noProtocol}))
+}
+
+func TestExpandNetworkingSecGroupV2Rule(t *testing.T) {
+	rawRule := testNetworkingSecGroupV2Rule("", "")
+	rawRule["self"] = true
+
+	expected := networkingSecGroupRuleV2CreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Direction:     rules.DirIngress,
+			EtherType:     rules.EtherType4,
+			SecGroupID:    "sg_1",
+			PortRangeMin:  22,
+			PortRangeMax:  22,
+			Protocol:      rules.ProtocolTCP,
+			RemoteGroupID: "sg_1",
+		},
+	}
+
+	actual, err := expandNetworkingSecGroupV2Rule("sg_1", rawRule)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	rawRule["self"] = false
+	rawRule["remote_address_group_id"] = "ag_1"
+	actual, err = expandNetworkingSecGroupV2Rule("sg_1", rawRule)
+	assert.NoError(t, err)
+	assert.Equal(t, "ag_1", actual.RemoteAddressGroupID)
+	assert.Equal(t, "", actual.RemoteGroupID)
+
+	rawRule["direction"] = "sideways"
+	_, err = expandNetworkingSecGroupV2Rule("sg_1", rawRule)
+	assert.Error(t, err)
+}
+
+func TestFlattenNetworkingSecGroupV2Rules(t *testing.T) {
+	sgRules := []networkingSecGroupRuleV2{
+		{
+			SecGroupRule: rules.SecGroupRule{
+				ID:             "rule_1",
+				Direction:      "ingress",
+				EtherType:      "IPv4",
+				Protocol:       "tcp",
+				PortRangeMin:   22,
+				PortRangeMax:   22,
+				RemoteIPPrefix: "10.0.0.0/8",
+			},
+		},
+		{
+			SecGroupRule: rules.SecGroupRule{
+				ID:            "rule_2",
+				Direction:     "ingress",
+				EtherType:     "IPv6",
+				RemoteGroupID: "sg_1",
+			},
+		},
+		{
+			SecGroupRule: rules.SecGroupRule{
+				ID:        "rule_3",
+				Direction: "ingress",
+				EtherType: "IPv4",
+			},
+			RemoteAddressGroupID: "ag_1",
+		},
+	}
+
+	actual := flattenNetworkingSecGroupV2Rules("sg_1", sgRules)
+
+	assert.Equal(t, testNetworkingSecGroupV2Rule("rule_1", "10.0.0.0/8"), actual[0])
+	assert.Equal(t, true, actual[1]["self"])
+	assert.Equal(t, "", actual[1]["remote_group_id"])
+	assert.Equal(t, "ag_1", actual[2]["remote_address_group_id"])
+}
+
+func TestNetworkingSecGroupV2RuleHash(t *testing.T) {
+	// The ID of a rule is unknown in the configuration.
+	configured := testNetworkingSecGroupV2Rule("", "10.0.0.0/8")
+	read := testNetworkingSecGroupV2Rule("rule_1", "10.0.0.0/8")
+	assert.Equal(t, networkingSecGroupV2RuleHash(configured), networkingSecGroupV2RuleHash(read))
+
+	other := testNetworkingSecGroupV2Rule("", "192.168.0.0/16")
+	assert.NotEqual(t, networkingSecGroupV2RuleHash(configured), networkingSecGroupV2RuleHash(other))
+
+	addressGroup := testNetworkingSecGroupV2Rule("", "")
+	addressGroup["remote_address_group_id"] = "ag_1"
+	assert.NotEqual(t, networkingSecGroupV2RuleHash(testNetworkingSecGroupV2Rule("", "")), networkingSecGroupV2RuleHash(addressGroup))
+}
+
+func TestNetworkingSecGroupV2UpdateRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules/rule_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var created int
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "security_group_rule": {
+    "direction": "ingress",
+    "ethertype": "IPv4",
+    "protocol": "tcp",
+    "port_range_min": 22,
+    "port_range_max": 22,
+    "remote_ip_prefix": "192.168.0.0/16",
+    "security_group_id": "sg_1"
+  }
+}`)
+		created++
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"security_group_rule": {"id": "rule_3"}}`)
+	})
+
+	unchanged := testNetworkingSecGroupV2Rule("rule_2", "172.16.0.0/12")
+	oldRules := schema.NewSet(networkingSecGroupV2RuleHash, []interface{}{
+		testNetworkingSecGroupV2Rule("rule_1", "10.0.0.0/8"),
+		unchanged,
+	})
+	newRules := schema.NewSet(networkingSecGroupV2RuleHash, []interface{}{
+		testNetworkingSecGroupV2Rule("", "192.168.0.0/16"),
+		testNetworkingSecGroupV2Rule("", "172.16.0.0/12"),
+	})
+
+	client := thclient.ServiceClient()
+	client.ResourceBase = client.Endpoint + "v2.0/"
+
+	err := networkingSecGroupV2UpdateRules(client, "sg_1", oldRules, newRules)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created)
+}
@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// networkingSegmentV2 represents a network segment. gophercloud doesn't
+// implement the segments extension, so the requests are issued directly.
+type networkingSegmentV2 struct {
+	ID              string `json:"id"`
+	NetworkID       string `json:"network_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	NetworkType     string `json:"network_type"`
+	PhysicalNetwork string `json:"physical_network"`
+	SegmentationID  int    `json:"segmentation_id"`
+}
+
+// networkingSegmentV2CreateOpts represents the body of a segment creation.
+type networkingSegmentV2CreateOpts struct {
+	NetworkID       string `json:"network_id" required:"true"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	NetworkType     string `json:"network_type" required:"true"`
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+	SegmentationID  int    `json:"segmentation_id,omitempty"`
+}
+
+// networkingSegmentV2UpdateOpts represents the body of a segment update.
+// Only the name and the description of a segment can be updated.
+type networkingSegmentV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// networkingSegmentV2Result represents the response of a single segment.
+type networkingSegmentV2Result struct {
+	Segment networkingSegmentV2 `json:"segment"`
+}
+
+// networkingSegmentV2Create creates a new segment.
+func networkingSegmentV2Create(client *gophercloud.ServiceClient, opts networkingSegmentV2CreateOpts) (*networkingSegmentV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "segment")
+	if err != nil {
+		return nil, err
+	}
+
+	var r networkingSegmentV2Result
+	_, err = client.Post(client.ServiceURL("segments"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Segment, nil
+}
+
+// networkingSegmentV2Get returns the segment with the given ID.
+func networkingSegmentV2Get(client *gophercloud.ServiceClient, id string) (*networkingSegmentV2, error) {
+	var r networkingSegmentV2Result
+	_, err := client.Get(client.ServiceURL("segments", id), &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Segment, nil
+}
+
+// networkingSegmentV2Update updates the segment with the given ID.
+func networkingSegmentV2Update(client *gophercloud.ServiceClient, id string, opts networkingSegmentV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "segment")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("segments", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// networkingSegmentV2Delete deletes the segment with the given ID.
+func networkingSegmentV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("segments", id), nil)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingSegmentV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "segment": {
+    "network_id": "network_1",
+    "name": "rack_1",
+    "network_type": "vlan",
+    "physical_network": "physnet_rack_1",
+    "segmentation_id": 2016
+  }
+}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+{
+  "segment": {
+    "id": "segment_1",
+    "network_id": "network_1",
+    "name": "rack_1",
+    "description": "",
+    "network_type": "vlan",
+    "physical_network": "physnet_rack_1",
+    "segmentation_id": 2016
+  }
+}`)
+	})
+
+	opts := networkingSegmentV2CreateOpts{
+		NetworkID:       "network_1",
+		Name:            "rack_1",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet_rack_1",
+		SegmentationID:  2016,
+	}
+
+	expected := &networkingSegmentV2{
+		ID:              "segment_1",
+		NetworkID:       "network_1",
+		Name:            "rack_1",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet_rack_1",
+		SegmentationID:  2016,
+	}
+
+	actual, err := networkingSegmentV2Create(thclient.ServiceClient(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingSegmentV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/segments/segment_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "segment": {
+    "id": "segment_1",
+    "network_id": "network_1",
+    "name": null,
+    "description": null,
+    "network_type": "flat",
+    "physical_network": "physnet_rack_1",
+    "segmentation_id": null
+  }
+}`)
+	})
+
+	expected := &networkingSegmentV2{
+		ID:              "segment_1",
+		NetworkID:       "network_1",
+		NetworkType:     "flat",
+		PhysicalNetwork: "physnet_rack_1",
+	}
+
+	actual, err := networkingSegmentV2Get(thclient.ServiceClient(), "segment_1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingSegmentV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/segments/segment_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"segment": {"description": ""}}`)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"segment": {"id": "segment_1"}}`)
+	})
+
+	description := ""
+	opts := networkingSegmentV2UpdateOpts{
+		Description: &description,
+	}
+
+	err := networkingSegmentV2Update(thclient.ServiceClient(), "segment_1", opts)
+	assert.NoError(t, err)
+}
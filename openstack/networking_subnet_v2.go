@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"net"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -11,6 +12,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// networkingSubnetV2 represents a subnet with its segment. gophercloud
+// doesn't support the segment_id field of a subnet.
+type networkingSubnetV2 struct {
+	subnets.Subnet
+	SegmentID string `json:"segment_id"`
+}
+
+// networkingSubnetV2UpdateOpts represents the attributes used when updating
+// a subnet, including its segment.
+type networkingSubnetV2UpdateOpts struct {
+	subnets.UpdateOpts
+	SegmentID *string `json:"segment_id,omitempty"`
+}
+
+// ToSubnetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to add the SegmentID field.
+func (opts networkingSubnetV2UpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToSubnetUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SegmentID != nil {
+		b["subnet"].(map[string]interface{})["segment_id"] = *opts.SegmentID
+	}
+
+	return b, nil
+}
+
 // networkingSubnetV2StateRefreshFunc returns a standard resource.StateRefreshFunc to wait for subnet status.
 func networkingSubnetV2StateRefreshFunc(client *gophercloud.ServiceClient, subnetID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -183,3 +213,55 @@ func networkingSubnetV2DNSNameserverAreUnique(raw []interface{}) error {
 
 	return nil
 }
+
+// networkingSubnetV2IPv6ModesCustomizeDiff rejects combinations of the IPv6
+// modes, which are refused by Neutron.
+func networkingSubnetV2IPv6ModesCustomizeDiff(diff *schema.ResourceDiff) error {
+	// The prefix length is unknown, when the CIDR is allocated from a pool.
+	var prefixLength int
+	if v := diff.Get("prefix_length").(int); v > 0 {
+		prefixLength = v
+	} else if cidr := diff.Get("cidr").(string); cidr != "" && diff.NewValueKnown("cidr") {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err == nil {
+			prefixLength, _ = ipNet.Mask.Size()
+		}
+	}
+
+	return networkingSubnetV2IPv6ModesValidate(
+		diff.Get("ip_version").(int),
+		diff.Get("ipv6_address_mode").(string),
+		diff.Get("ipv6_ra_mode").(string),
+		diff.Get("enable_dhcp").(bool),
+		prefixLength,
+	)
+}
+
+// networkingSubnetV2IPv6ModesValidate validates the IPv6 modes of a subnet.
+// A prefixLength of 0 skips the checks of the prefix length.
+func networkingSubnetV2IPv6ModesValidate(ipVersion int, addressMode, raMode string, enableDHCP bool, prefixLength int) error {
+	if addressMode == "" && raMode == "" {
+		return nil
+	}
+
+	if ipVersion != 6 {
+		return fmt.Errorf("ipv6_address_mode and ipv6_ra_mode can only be set, when ip_version is 6")
+	}
+
+	if !enableDHCP {
+		return fmt.Errorf("ipv6_address_mode and ipv6_ra_mode can only be set, when enable_dhcp is true")
+	}
+
+	if addressMode != "" && raMode != "" && addressMode != raMode {
+		return fmt.Errorf("ipv6_address_mode %q and ipv6_ra_mode %q must be equal, when both are set", addressMode, raMode)
+	}
+
+	// SLAAC derives the addresses from the MAC addresses, which requires a /64.
+	for _, mode := range []string{addressMode, raMode} {
+		if (mode == "slaac" || mode == "dhcpv6-stateless") && prefixLength != 0 && prefixLength != 64 {
+			return fmt.Errorf("The %s IPv6 mode requires a prefix length of 64, got %d", mode, prefixLength)
+		}
+	}
+
+	return nil
+}
@@ -229,3 +229,55 @@ func TestNetworkingSubnetV2DNSNameserverAreUnique(t *testing.T) {
 		assert.Equal(t, test.err, networkingSubnetV2DNSNameserverAreUnique(test.input))
 	}
 }
+
+func TestNetworkingSubnetV2IPv6ModesValidate(t *testing.T) {
+	tableTest := []struct {
+		ipVersion    int
+		addressMode  string
+		raMode       string
+		enableDHCP   bool
+		prefixLength int
+		valid        bool
+	}{
+		{4, "", "", true, 24, true},
+		{6, "slaac", "slaac", true, 64, true},
+		{6, "dhcpv6-stateful", "", true, 56, true},
+		{6, "", "dhcpv6-stateless", true, 0, true},
+		{4, "slaac", "", true, 24, false},
+		{6, "slaac", "", false, 64, false},
+		{6, "slaac", "dhcpv6-stateful", true, 64, false},
+		{6, "slaac", "", true, 56, false},
+		{6, "", "dhcpv6-stateless", true, 48, false},
+	}
+
+	for _, test := range tableTest {
+		err := networkingSubnetV2IPv6ModesValidate(test.ipVersion, test.addressMode, test.raMode, test.enableDHCP, test.prefixLength)
+		if test.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestNetworkingSubnetV2UpdateOpts(t *testing.T) {
+	name := "subnet_1"
+	segmentID := "segment_1"
+	opts := networkingSubnetV2UpdateOpts{
+		UpdateOpts: subnets.UpdateOpts{
+			Name: &name,
+		},
+		SegmentID: &segmentID,
+	}
+
+	expected := map[string]interface{}{
+		"subnet": map[string]interface{}{
+			"name":       "subnet_1",
+			"segment_id": "segment_1",
+		},
+	}
+
+	actual, err := opts.ToSubnetUpdateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -1,6 +1,8 @@
 package openstack
 
 import (
+	"sync"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -13,6 +15,10 @@ import (
 // Config struct.
 type Config struct {
 	auth.Config
+
+	// maxMicroversions caches the maximum microversions of the
+	// microversioned APIs by their endpoints.
+	maxMicroversions sync.Map
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -251,12 +257,19 @@ func Provider() terraform.ResourceProvider {
 			"openstack_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
 			"openstack_blockstorage_volume_v2":                   dataSourceBlockStorageVolumeV2(),
 			"openstack_blockstorage_volume_v3":                   dataSourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_volume_type_v3":              dataSourceBlockStorageVolumeTypeV3(),
 			"openstack_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
 			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
 			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
+			"openstack_compute_instance_actions_v2":              dataSourceComputeInstanceActionsV2(),
+			"openstack_compute_instance_console_v2":              dataSourceComputeInstanceConsoleV2(),
+			"openstack_compute_instances_v2":                     dataSourceComputeInstancesV2(),
 			"openstack_compute_flavor_v2":                        dataSourceComputeFlavorV2(),
 			"openstack_compute_hypervisor_v2":                    dataSourceComputeHypervisorV2(),
+			"openstack_compute_hypervisors_v2":                   dataSourceComputeHypervisorsV2(),
 			"openstack_compute_keypair_v2":                       dataSourceComputeKeypairV2(),
+			"openstack_compute_limits_v2":                        dataSourceComputeLimitsV2(),
+			"openstack_compute_usage_v2":                         dataSourceComputeUsageV2(),
 			"openstack_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
 			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
@@ -280,6 +293,7 @@ func Provider() terraform.ResourceProvider {
 			"openstack_networking_secgroup_v2":                   dataSourceNetworkingSecGroupV2(),
 			"openstack_networking_subnetpool_v2":                 dataSourceNetworkingSubnetPoolV2(),
 			"openstack_networking_floatingip_v2":                 dataSourceNetworkingFloatingIPV2(),
+			"openstack_networking_portforwarding_v2":             dataSourceNetworkingPortForwardingV2(),
 			"openstack_networking_router_v2":                     dataSourceNetworkingRouterV2(),
 			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
 			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
@@ -293,13 +307,21 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"openstack_blockstorage_backup_v3":                   resourceBlockStorageBackupV3(),
 			"openstack_blockstorage_quotaset_v2":                 resourceBlockStorageQuotasetV2(),
 			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_snapshot_v3":                 resourceBlockStorageSnapshotV3(),
 			"openstack_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
 			"openstack_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
 			"openstack_blockstorage_volume_v3":                   resourceBlockStorageVolumeV3(),
 			"openstack_blockstorage_volume_attach_v2":            resourceBlockStorageVolumeAttachV2(),
 			"openstack_blockstorage_volume_attach_v3":            resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_volume_type_v3":              resourceBlockStorageVolumeTypeV3(),
+			"openstack_blockstorage_volume_type_access_v3":       resourceBlockStorageVolumeTypeAccessV3(),
+			"openstack_blockstorage_qos_v3":                      resourceBlockStorageQoSV3(),
+			"openstack_blockstorage_qos_association_v3":          resourceBlockStorageQoSAssociationV3(),
+			"openstack_blockstorage_volume_transfer_v3":          resourceBlockStorageVolumeTransferV3(),
+			"openstack_blockstorage_volume_transfer_accept_v3":   resourceBlockStorageVolumeTransferAcceptV3(),
 			"openstack_compute_aggregate_v2":                     resourceComputeAggregateV2(),
 			"openstack_compute_flavor_v2":                        resourceComputeFlavorV2(),
 			"openstack_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
@@ -309,6 +331,7 @@ func Provider() terraform.ResourceProvider {
 			"openstack_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
 			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
 			"openstack_compute_quotaset_v2":                      resourceComputeQuotasetV2(),
+			"openstack_compute_service_v2":                       resourceComputeServiceV2(),
 			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
 			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
 			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
@@ -347,8 +370,10 @@ func Provider() terraform.ResourceProvider {
 			"openstack_lb_monitor_v2":                            resourceMonitorV2(),
 			"openstack_lb_l7policy_v2":                           resourceL7PolicyV2(),
 			"openstack_lb_l7rule_v2":                             resourceL7RuleV2(),
+			"openstack_networking_address_group_v2":              resourceNetworkingAddressGroupV2(),
 			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
 			"openstack_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
+			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
 			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
 			"openstack_networking_port_v2":                       resourceNetworkingPortV2(),
 			"openstack_networking_rbac_policy_v2":                resourceNetworkingRBACPolicyV2(),
@@ -363,6 +388,7 @@ func Provider() terraform.ResourceProvider {
 			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
 			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
 			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_segment_v2":                    resourceNetworkingSegmentV2(),
 			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
 			"openstack_networking_subnet_route_v2":               resourceNetworkingSubnetRouteV2(),
 			"openstack_networking_subnetpool_v2":                 resourceNetworkingSubnetPoolV2(),
@@ -477,7 +503,7 @@ func init() {
 
 func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -395,7 +395,7 @@ func testAccAuthFromEnv() (*Config, error) {
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:        os.Getenv("OS_CACERT"),
 			ClientCertFile:    os.Getenv("OS_CERT"),
 			ClientKeyFile:     os.Getenv("OS_KEY"),
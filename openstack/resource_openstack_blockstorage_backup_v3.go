@@ -0,0 +1,293 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageBackupV3Create,
+		Read:   resourceBlockStorageBackupV3Read,
+		Update: resourceBlockStorageBackupV3Update,
+		Delete: resourceBlockStorageBackupV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	createOpts := backups.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Container:   d.Get("container").(string),
+		Incremental: d.Get("incremental").(bool),
+		Force:       d.Get("force").(bool),
+		SnapshotID:  d.Get("snapshot_id").(string),
+		Metadata:    expandToMapStringString(metadata),
+	}
+
+	if len(createOpts.Metadata) > 0 {
+		supported, err := blockStorageV3MicroversionSupported(config, blockStorageClient, blockStorageBackupV3MetadataMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack block storage API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Setting metadata on openstack_blockstorage_backup_v3 requires microversion %s, "+
+				"which isn't supported by the block storage API", blockStorageBackupV3MetadataMicroversion)
+		}
+
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 create options: %#v", createOpts)
+
+	b, err := backups.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_backup_v3: %s", err)
+	}
+
+	d.SetId(b.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, b.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to become ready: %s", b.ID, err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// The backup metadata is only returned with the 3.43 microversion.
+	metadataSupported, err := blockStorageV3MicroversionSupported(config, blockStorageClient, blockStorageBackupV3MetadataMicroversion)
+	if err != nil {
+		return fmt.Errorf("Error checking the microversion of the OpenStack block storage API: %s", err)
+	}
+
+	if metadataSupported {
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	b, err := backups.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_v3 %s: %#v", d.Id(), b)
+
+	d.Set("volume_id", b.VolumeID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("container", b.Container)
+	d.Set("incremental", b.IsIncremental)
+	d.Set("snapshot_id", b.SnapshotID)
+	d.Set("size", b.Size)
+	d.Set("status", b.Status)
+	d.Set("object_count", b.ObjectCount)
+	d.Set("has_dependent_backups", b.HasDependentBackups)
+	d.Set("region", GetRegion(d, config))
+
+	if metadataSupported {
+		if err := d.Set("metadata", flattenBlockStorageBackupV3Metadata(b)); err != nil {
+			log.Printf("[DEBUG] Unable to set metadata for openstack_blockstorage_backup_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceBlockStorageBackupV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if !d.HasChanges("name", "description", "metadata") {
+		return resourceBlockStorageBackupV3Read(d, meta)
+	}
+
+	// Updating a backup requires the 3.9 microversion,
+	// updating its metadata requires the 3.43 microversion.
+	microversion := blockStorageBackupV3UpdateMicroversion
+
+	var updateOpts backups.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("metadata") {
+		metadata := d.Get("metadata").(map[string]interface{})
+		updateOpts.Metadata = expandToMapStringString(metadata)
+		microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	supported, err := blockStorageV3MicroversionSupported(config, blockStorageClient, microversion)
+	if err != nil {
+		return fmt.Errorf("Error checking the microversion of the OpenStack block storage API: %s", err)
+	}
+
+	if !supported {
+		return fmt.Errorf("Updating openstack_blockstorage_backup_v3 %s requires microversion %s, "+
+			"which isn't supported by the block storage API", d.Id(), microversion)
+	}
+
+	blockStorageClient.Microversion = microversion
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = backups.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_blockstorage_backup_v3 %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := backups.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_backup_v3")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_blockstorage_backup_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
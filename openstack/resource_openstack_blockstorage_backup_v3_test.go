@@ -0,0 +1,173 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func TestAccBlockStorageV3Backup_basic(t *testing.T) {
+	var backup backups.Backup
+	backupName := acctest.RandomWithPrefix("tf-acc-backup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic(backupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", backupName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "description", "first test backup"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "size", "1"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3BackupUpdate(backupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", backupName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "description", "updated test backup"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_restore(t *testing.T) {
+	var backup backups.Backup
+	backupName := acctest.RandomWithPrefix("tf-acc-backup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupRestore(backupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_v3.volume_2", "backup_id", &backup.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_2", "size", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3BackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_backup_v3" {
+			continue
+		}
+
+		_, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3BackupExists(n string, backup *backups.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3BackupBasic(backupName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  name        = "%s"
+  description = "first test backup"
+}
+`, backupName)
+}
+
+func testAccBlockStorageV3BackupUpdate(backupName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  name        = "%s"
+  description = "updated test backup"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, backupName)
+}
+
+func testAccBlockStorageV3BackupRestore(backupName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name      = "volume_2"
+  size      = 1
+  backup_id = "${openstack_blockstorage_backup_v3.backup_1.id}"
+}
+`, testAccBlockStorageV3BackupBasic(backupName))
+}
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/qos"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageQoSAssociationV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSAssociationV3Create,
+		Read:   resourceBlockStorageQoSAssociationV3Read,
+		Delete: resourceBlockStorageQoSAssociationV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"qos_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"volume_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSAssociationV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID := d.Get("qos_id").(string)
+	volumeTypeID := d.Get("volume_type_id").(string)
+
+	associateOpts := qos.AssociateOpts{
+		VolumeTypeID: volumeTypeID,
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_qos_association_v3 create options: %#v", associateOpts)
+
+	if err := qos.Associate(blockStorageClient, qosID, associateOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("Error associating openstack_blockstorage_qos_v3 %s with openstack_blockstorage_volume_type_v3 %s: %s", qosID, volumeTypeID, err)
+	}
+
+	id := fmt.Sprintf("%s/%s", qosID, volumeTypeID)
+	d.SetId(id)
+
+	return resourceBlockStorageQoSAssociationV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSAssociationV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := getBlockStorageQoSAssociation(blockStorageClient, qosID, volumeTypeID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_qos_association_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_qos_association_v3 %s: %#v", d.Id(), association)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("qos_id", qosID)
+	d.Set("volume_type_id", association.ID)
+
+	return nil
+}
+
+func resourceBlockStorageQoSAssociationV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	disassociateOpts := qos.DisassociateOpts{
+		VolumeTypeID: volumeTypeID,
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_qos_association_v3 delete options: %#v", disassociateOpts)
+
+	if err := qos.Disassociate(blockStorageClient, qosID, disassociateOpts).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_qos_association_v3")
+	}
+
+	return nil
+}
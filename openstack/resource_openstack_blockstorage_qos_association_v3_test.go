@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBlockStorageV3QoSAssociation_basic(t *testing.T) {
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+	volumeTypeName := acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSAssociationBasic(qosName, volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSAssociationExists("openstack_blockstorage_qos_association_v3.qos_association_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.qos_association_1", "qos_id",
+						"openstack_blockstorage_qos_v3.qos_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.qos_association_1", "volume_type_id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QoSAssociationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_qos_association_v3" {
+			continue
+		}
+
+		qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getBlockStorageQoSAssociation(blockStorageClient, qosID, volumeTypeID)
+		if err == nil {
+			return fmt.Errorf("QoS association still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3QoSAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getBlockStorageQoSAssociation(blockStorageClient, qosID, volumeTypeID)
+
+		return err
+	}
+}
+
+func testAccBlockStorageV3QoSAssociationBasic(qosName, volumeTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "%s"
+
+  specs = {
+    read_iops_sec = "20000"
+  }
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_qos_association_v3" "qos_association_1" {
+  qos_id         = "${openstack_blockstorage_qos_v3.qos_1.id}"
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+}
+`, qosName, volumeTypeName)
+}
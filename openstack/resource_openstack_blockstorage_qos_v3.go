@@ -0,0 +1,171 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/qos"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceBlockStorageQoSV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSV3Create,
+		Read:   resourceBlockStorageQoSV3Read,
+		Update: resourceBlockStorageQoSV3Update,
+		Delete: resourceBlockStorageQoSV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"consumer": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "back-end",
+				ValidateFunc: validation.StringInSlice([]string{
+					"front-end", "back-end", "both",
+				}, false),
+			},
+
+			"specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := qos.CreateOpts{
+		Name:     d.Get("name").(string),
+		Consumer: qos.QoSConsumer(d.Get("consumer").(string)),
+		Specs:    expandBlockStorageQoSV3Specs(d.Get("specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_qos_v3 create options: %#v", createOpts)
+
+	q, err := qos.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_qos_v3 %s: %s", createOpts.Name, err)
+	}
+
+	d.SetId(q.ID)
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	q, err := qos.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_qos_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_qos_v3 %s: %#v", d.Id(), q)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", q.Name)
+	d.Set("consumer", q.Consumer)
+
+	if err := d.Set("specs", q.Specs); err != nil {
+		log.Printf("[WARN] Unable to set specs for openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageQoSV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	hasChange := false
+	var updateOpts qos.UpdateOpts
+
+	if d.HasChange("consumer") {
+		hasChange = true
+		updateOpts.Consumer = qos.QoSConsumer(d.Get("consumer").(string))
+	}
+
+	if d.HasChange("specs") {
+		oldSpecs, newSpecs := d.GetChange("specs")
+		newSpecsRaw := newSpecs.(map[string]interface{})
+
+		// Delete the specs which are no longer present.
+		var deleteKeys qos.DeleteKeysOpts
+		for oldKey := range oldSpecs.(map[string]interface{}) {
+			if _, ok := newSpecsRaw[oldKey]; !ok {
+				deleteKeys = append(deleteKeys, oldKey)
+			}
+		}
+
+		if len(deleteKeys) > 0 {
+			log.Printf("[DEBUG] openstack_blockstorage_qos_v3 %s delete keys: %#v", d.Id(), deleteKeys)
+
+			if err := qos.DeleteKeys(blockStorageClient, d.Id(), deleteKeys).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting specs from openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+			}
+		}
+
+		// Create or update the remaining specs.
+		if len(newSpecsRaw) > 0 {
+			hasChange = true
+			updateOpts.Specs = expandBlockStorageQoSV3Specs(newSpecsRaw)
+		}
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_qos_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = qos.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = qos.Delete(blockStorageClient, d.Id(), nil).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_qos_v3")
+	}
+
+	return nil
+}
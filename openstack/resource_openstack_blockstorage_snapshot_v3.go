@@ -0,0 +1,243 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageSnapshotV3Create,
+		Read:   resourceBlockStorageSnapshotV3Read,
+		Update: resourceBlockStorageSnapshotV3Update,
+		Delete: resourceBlockStorageSnapshotV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSnapshotV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Force:       d.Get("force").(bool),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Metadata:    expandToMapStringString(metadata),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 create options: %#v", createOpts)
+
+	s, err := snapshots.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_snapshot_v3: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, s.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_snapshot_v3 %s to become ready: %s", s.ID, err)
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	s, err := snapshots.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_snapshot_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_snapshot_v3 %s: %#v", d.Id(), s)
+
+	d.Set("volume_id", s.VolumeID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("size", s.Size)
+	d.Set("status", s.Status)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("metadata", s.Metadata); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_blockstorage_snapshot_v3 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageSnapshotV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+		updateOpts := snapshots.UpdateOpts{
+			Name:        &name,
+			Description: &description,
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = snapshots.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_snapshot_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		// The snapshot metadata is replaced as a whole.
+		metadataOpts := snapshots.UpdateMetadataOpts{
+			Metadata: d.Get("metadata").(map[string]interface{}),
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 %s metadata options: %#v", d.Id(), metadataOpts)
+
+		_, err = snapshots.UpdateMetadata(blockStorageClient, d.Id(), metadataOpts).ExtractMetadata()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata for openstack_blockstorage_snapshot_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"updating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_snapshot_v3 %s to become ready: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// Refuse to delete a snapshot which still backs existing volumes.
+	volumeIDs, err := blockStorageSnapshotV3DependentVolumes(blockStorageClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error checking openstack_blockstorage_snapshot_v3 %s dependent volumes: %s", d.Id(), err)
+	}
+
+	if len(volumeIDs) > 0 {
+		return fmt.Errorf("Unable to delete openstack_blockstorage_snapshot_v3 %s: "+
+			"the snapshot still backs the following volumes: %s", d.Id(), strings.Join(volumeIDs, ", "))
+	}
+
+	if err := snapshots.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_snapshot_v3")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_blockstorage_snapshot_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+)
+
+func TestAccBlockStorageV3Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+	snapshotName := acctest.RandomWithPrefix("tf-acc-snapshot")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3SnapshotBasic(snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", snapshotName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", "first test snapshot"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3SnapshotUpdate(snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", snapshotName+"-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", "updated test snapshot"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.abc", "def"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_snapshot_v3" {
+			continue
+		}
+
+		_, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3SnapshotExists(n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3SnapshotBasic(snapshotName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  name        = "%s"
+  description = "first test snapshot"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, snapshotName)
+}
+
+func testAccBlockStorageV3SnapshotUpdate(snapshotName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  name        = "%s-updated"
+  description = "updated test snapshot"
+
+  metadata = {
+    abc = "def"
+  }
+}
+`, snapshotName)
+}
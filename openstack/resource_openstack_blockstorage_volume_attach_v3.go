@@ -5,7 +5,9 @@ import (
 	"log"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/attachments"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -18,6 +20,9 @@ func resourceBlockStorageVolumeAttachV3() *schema.Resource {
 		Create: resourceBlockStorageVolumeAttachV3Create,
 		Read:   resourceBlockStorageVolumeAttachV3Read,
 		Delete: resourceBlockStorageVolumeAttachV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -53,6 +58,7 @@ func resourceBlockStorageVolumeAttachV3() *schema.Resource {
 			"attach_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"ro", "rw",
@@ -129,8 +135,92 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	// initialize the connection
 	volumeID := d.Get("volume_id").(string)
+
+	// Use the attachments API, when it's supported by the Block Storage service.
+	microversion, err := blockStorageVolumeAttachV3AttachmentsMicroversion(config, client, d.Get("attach_mode").(string))
+	if err != nil {
+		return fmt.Errorf("Unable to determine the microversion for openstack_blockstorage_volume_attach_v3: %s", err)
+	}
+
+	var attachmentID string
+	if microversion != "" {
+		client.Microversion = microversion
+		attachmentID, err = resourceBlockStorageVolumeAttachV3CreateAttachment(d, client, volumeID)
+	} else {
+		attachmentID, err = resourceBlockStorageVolumeAttachV3CreateLegacy(d, client, volumeID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The ID must be a combination of the volume and attachment ID
+	// since a volume ID is required to retrieve an attachment ID.
+	id := fmt.Sprintf("%s/%s", volumeID, attachmentID)
+	d.SetId(id)
+
+	return resourceBlockStorageVolumeAttachV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeAttachV3CreateAttachment(d *schema.ResourceData, client *gophercloud.ServiceClient, volumeID string) (string, error) {
+	createOpts := blockStorageVolumeAttachV3AttachmentCreateOpts{
+		attachments.CreateOpts{
+			VolumeUUID: volumeID,
+			Connector:  expandBlockStorageVolumeAttachV3Connector(d),
+			Mode:       d.Get("attach_mode").(string),
+		},
+	}
+
+	// Only uncomment this when debugging since the connector contains sensitive information.
+	// log.Printf("[DEBUG] openstack_blockstorage_volume_attach_v3 create options: %#v", createOpts)
+
+	attachment, err := attachments.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf(
+			"Error creating openstack_blockstorage_volume_attach_v3 attachment for volume %s: %s", volumeID, err)
+	}
+
+	// Because this information is only returned upon creation,
+	// it must be set in Create.
+	d.Set("data", flattenBlockStorageVolumeAttachV3ConnectionData(attachment.ConnectionInfo))
+
+	if v, ok := attachment.ConnectionInfo["driver_volume_type"]; ok {
+		d.Set("driver_volume_type", v)
+	}
+
+	if v, ok := attachment.ConnectionInfo["mount_point_base"]; ok {
+		d.Set("mount_point_base", v)
+	}
+
+	// Once the connection has been made, tell Cinder to mark the volume as attached.
+	if err := attachments.Complete(client, attachment.ID).ExtractErr(); err != nil {
+		return "", fmt.Errorf(
+			"Error completing openstack_blockstorage_volume_attach_v3 attachment %s: %s", attachment.ID, err)
+	}
+
+	log.Printf(
+		"[DEBUG] Waiting for openstack_blockstorage_volume_attach_v3 volume %s to become in-use", volumeID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "reserved", "attaching"},
+		Target:     []string{"in-use"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(client, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return "", fmt.Errorf(
+			"Error waiting for openstack_blockstorage_volume_attach_v3 volume %s to become in-use: %s", volumeID, err)
+	}
+
+	return attachment.ID, nil
+}
+
+func resourceBlockStorageVolumeAttachV3CreateLegacy(d *schema.ResourceData, client *gophercloud.ServiceClient, volumeID string) (string, error) {
+	// initialize the connection
 	connOpts := &volumeactions.InitializeConnectionOpts{}
 	if v, ok := d.GetOk("host_name"); ok {
 		connOpts.Host = v.(string)
@@ -172,7 +262,7 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 
 	connInfo, err := volumeactions.InitializeConnection(client, volumeID, connOpts).Extract()
 	if err != nil {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"Unable to initialize connection for openstack_blockstorage_volume_attach_v3: %s", err)
 	}
 
@@ -203,7 +293,7 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 	// Once the connection has been made, tell Cinder to mark the volume as attached.
 	attachMode, err := expandBlockStorageV3AttachMode(d.Get("attach_mode").(string))
 	if err != nil {
-		return nil
+		return "", err
 	}
 
 	attachOpts := &volumeactions.AttachOpts{
@@ -215,7 +305,7 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] openstack_blockstorage_volume_attach_v3 attach options: %#v", attachOpts)
 
 	if err := volumeactions.Attach(client, volumeID, attachOpts).ExtractErr(); err != nil {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"Error attaching openstack_blockstorage_volume_attach_v3 for volume %s: %s", volumeID, err)
 	}
 
@@ -234,7 +324,7 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 
 	_, err = stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"Error waiting for openstack_blockstorage_volume_attach_v3 volume %s to become in-use: %s", volumeID, err)
 	}
 
@@ -242,7 +332,7 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 	// retrieve a fresh copy of it with all information now available.
 	volume, err := volumes.Get(client, volumeID).Extract()
 	if err != nil {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"Unable to retrieve openstack_blockstorage_volume_attach_v3 volume %s: %s", volumeID, err)
 	}
 
@@ -256,16 +346,11 @@ func resourceBlockStorageVolumeAttachV3Create(d *schema.ResourceData, meta inter
 	}
 
 	if attachmentID == "" {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"Unable to determine attachment ID for openstack_blockstorage_volume_attach_v3 volume %s", volumeID)
 	}
 
-	// The ID must be a combination of the volume and attachment ID
-	// since a volume ID is required to retrieve an attachment ID.
-	id := fmt.Sprintf("%s/%s", volumeID, attachmentID)
-	d.SetId(id)
-
-	return resourceBlockStorageVolumeAttachV3Read(d, meta)
+	return attachmentID, nil
 }
 
 func resourceBlockStorageVolumeAttachV3Read(d *schema.ResourceData, meta interface{}) error {
@@ -282,22 +367,58 @@ func resourceBlockStorageVolumeAttachV3Read(d *schema.ResourceData, meta interfa
 
 	volume, err := volumes.Get(client, volumeID).Extract()
 	if err != nil {
-		return fmt.Errorf(
-			"Unable to retrieve openstack_blockstorage_volume_attach_v3 volume %s: %s", volumeID, err)
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_attach_v3 volume")
 	}
 
 	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_attach_v3 volume %s: %#v", volumeID, volume)
 
 	var attachment volumes.Attachment
+	var found bool
 	for _, v := range volume.Attachments {
 		if attachmentID == v.AttachmentID {
 			attachment = v
+			found = true
 		}
 	}
 
+	if !found {
+		log.Printf("[DEBUG] openstack_blockstorage_volume_attach_v3 attachment %s not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf(
 		"[DEBUG] Retrieved openstack_blockstorage_volume_attach_v3 attachment %s: %#v", d.Id(), attachment)
 
+	d.Set("region", GetRegion(d, config))
+	d.Set("volume_id", volumeID)
+
+	// The host name isn't always returned, keep the configured one then.
+	if attachment.HostName != "" {
+		d.Set("host_name", attachment.HostName)
+	}
+
+	if attachment.Device != "" {
+		d.Set("device", attachment.Device)
+	}
+
+	// The attach mode is only exposed by the attachments API.
+	microversion, err := blockStorageVolumeAttachV3AttachmentsMicroversion(config, client, "")
+	if err != nil {
+		return fmt.Errorf("Unable to determine the microversion for openstack_blockstorage_volume_attach_v3: %s", err)
+	}
+
+	if microversion != "" {
+		client.Microversion = microversion
+		a, err := attachments.Get(client, attachmentID).Extract()
+		if err != nil {
+			return fmt.Errorf(
+				"Unable to retrieve openstack_blockstorage_volume_attach_v3 attachment %s: %s", attachmentID, err)
+		}
+
+		d.Set("attach_mode", a.AttachMode)
+	}
+
 	return nil
 }
 
@@ -313,6 +434,47 @@ func resourceBlockStorageVolumeAttachV3Delete(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error parsing openstack_blockstorage_volume_attach_v3: %s", err)
 	}
 
+	microversion, err := blockStorageVolumeAttachV3AttachmentsMicroversion(config, client, "")
+	if err != nil {
+		return fmt.Errorf("Unable to determine the microversion for openstack_blockstorage_volume_attach_v3: %s", err)
+	}
+
+	if microversion != "" {
+		client.Microversion = microversion
+
+		log.Printf("[DEBUG] Deleting openstack_blockstorage_volume_attach_v3 attachment %s", d.Id())
+
+		if err := attachments.Delete(client, attachmentID).ExtractErr(); err != nil {
+			return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_attach_v3 attachment")
+		}
+	} else {
+		err = resourceBlockStorageVolumeAttachV3DeleteLegacy(d, client, volumeID, attachmentID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Other attachments of a multiattach volume may still exist,
+	// so wait for this attachment to disappear.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"attached"},
+		Target:     []string{"detached"},
+		Refresh:    blockStorageVolumeAttachV3AttachmentStateRefreshFunc(client, volumeID, attachmentID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_volume_attach_v3 attachment %s to be detached: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageVolumeAttachV3DeleteLegacy(d *schema.ResourceData, client *gophercloud.ServiceClient, volumeID, attachmentID string) error {
 	// Terminate the connection
 	termOpts := &volumeactions.TerminateConnectionOpts{}
 	if v, ok := d.GetOk("host_name"); ok {
@@ -353,7 +515,7 @@ func resourceBlockStorageVolumeAttachV3Delete(d *schema.ResourceData, meta inter
 		termOpts.Wwpns = wwpns
 	}
 
-	err = volumeactions.TerminateConnection(client, volumeID, termOpts).ExtractErr()
+	err := volumeactions.TerminateConnection(client, volumeID, termOpts).ExtractErr()
 	if err != nil {
 		return fmt.Errorf(
 			"Error terminating openstack_blockstorage_volume_attach_v3 connection %s: %s", d.Id(), err)
@@ -371,20 +533,5 @@ func resourceBlockStorageVolumeAttachV3Delete(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"in-use", "attaching", "detaching"},
-		Target:     []string{"available"},
-		Refresh:    blockStorageVolumeV3StateRefreshFunc(client, volumeID),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for openstack_blockstorage_volume_attach_v3 volume %s to become available: %s", volumeID, err)
-	}
-
 	return nil
 }
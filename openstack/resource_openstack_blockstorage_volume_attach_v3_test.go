@@ -53,6 +53,32 @@ func TestAccBlockStorageVolumeAttachV3_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageVolumeAttachV3_multiattach(t *testing.T) {
+	var va1, va2 volumes.Attachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageVolumeAttachV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVolumeAttachV3Multiattach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageVolumeAttachV3Exists("openstack_blockstorage_volume_attach_v3.va_1", &va1),
+					testAccCheckBlockStorageVolumeAttachV3Exists("openstack_blockstorage_volume_attach_v3.va_2", &va2),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_attach_v3.va_1", "attach_mode", "rw"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_attach_v3.va_2", "attach_mode", "ro"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageVolumeAttachV3Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	client, err := config.BlockStorageV3Client(osRegionName)
@@ -170,3 +196,33 @@ resource "openstack_blockstorage_volume_attach_v3" "va_1" {
   }
 }
 `
+
+const testAccBlockStorageVolumeAttachV3Multiattach = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name        = "volume_1"
+  size        = 1
+  multiattach = true
+}
+
+resource "openstack_blockstorage_volume_attach_v3" "va_1" {
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  attach_mode = "rw"
+
+  host_name  = "devstack"
+  ip_address = "192.168.255.10"
+  initiator  = "iqn.1993-08.org.debian:01:e9861fb1859"
+  os_type    = "linux2"
+  platform   = "x86_64"
+}
+
+resource "openstack_blockstorage_volume_attach_v3" "va_2" {
+  volume_id   = "${openstack_blockstorage_volume_attach_v3.va_1.volume_id}"
+  attach_mode = "ro"
+
+  host_name  = "devstack-2"
+  ip_address = "192.168.255.11"
+  initiator  = "iqn.1993-08.org.debian:01:e9861fb1860"
+  os_type    = "linux2"
+  platform   = "x86_64"
+}
+`
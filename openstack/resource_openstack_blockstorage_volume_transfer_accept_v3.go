@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferAcceptV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferAcceptV3Create,
+		Read:   resourceBlockStorageVolumeTransferAcceptV3Read,
+		Delete: resourceBlockStorageVolumeTransferAcceptV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"transfer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auth_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			// Computed-only
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	acceptOpts := volumetransfers.AcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+
+	// accept the transfer on the recipient side
+	transfer, err := volumetransfers.Accept(blockStorageClient, transferID, acceptOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error accepting the %q volume transfer: %s", transferID, err)
+	}
+
+	d.SetId(transferID)
+	d.Set("volume_id", transfer.VolumeID)
+	d.Set("name", transfer.Name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"awaiting-transfer"},
+		Target:     []string{"available", "in-use"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, transfer.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to become available: %s", transfer.VolumeID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferAcceptV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	v, err := volumes.Get(blockStorageClient, volumeID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving the openstack_blockstorage_volume_transfer_accept_v3 volume")
+	}
+
+	log.Printf("[DEBUG] Retrieved the openstack_blockstorage_volume_transfer_accept_v3 %s volume: %#v", d.Id(), v)
+
+	d.Set("volume_id", v.ID)
+	d.Set("region", GetRegion(d, config))
+
+	// The transfer record is usually removed once it is accepted. Keep the
+	// name from the acceptance in that case.
+	transferID := d.Id()
+	transfer, err := volumetransfers.Get(blockStorageClient, transferID).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving the %q volume transfer: %s", transferID, err)
+		}
+
+		return nil
+	}
+
+	d.Set("name", transfer.Name)
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Delete(d *schema.ResourceData, meta interface{}) error {
+	// An accepted transfer can't be reverted, the volume stays
+	// within the recipient project.
+	log.Printf("[DEBUG] Removing openstack_blockstorage_volume_transfer_accept_v3 %s from the state", d.Id())
+
+	return nil
+}
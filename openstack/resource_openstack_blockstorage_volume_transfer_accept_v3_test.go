@@ -0,0 +1,101 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTransferAccept_basic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc-project")
+	userName := acctest.RandomWithPrefix("tf-acc-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTransferAcceptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferAcceptBasic(projectName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_accept_v3.accept_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_transfer_accept_v3.accept_1", "name", "transfer_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTransferAcceptDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_transfer_accept_v3" {
+			continue
+		}
+
+		_, err := volumes.Get(blockStorageClient, rs.Primary.Attributes["volume_id"]).Extract()
+		if err == nil {
+			return fmt.Errorf("Transferred volume still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccBlockStorageV3VolumeTransferAcceptBasic(projectName, userName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_identity_role_v3" "role_1" {
+  name = "member"
+}
+
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_identity_user_v3" "user_1" {
+  name               = "%s"
+  password           = "password123"
+  default_project_id = "${openstack_identity_project_v3.project_1.id}"
+}
+
+resource "openstack_identity_role_assignment_v3" "role_assignment_1" {
+  user_id    = "${openstack_identity_user_v3.user_1.id}"
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  role_id    = "${data.openstack_identity_role_v3.role_1.id}"
+}
+
+provider "openstack" {
+  alias       = "destination"
+  tenant_name = "${openstack_identity_project_v3.project_1.name}"
+  user_name   = "${openstack_identity_user_v3.user_1.name}"
+  password    = "${openstack_identity_user_v3.user_1.password}"
+}
+
+resource "openstack_blockstorage_volume_transfer_accept_v3" "accept_1" {
+  provider = "openstack.destination"
+
+  transfer_id = "${openstack_blockstorage_volume_transfer_v3.transfer_1.id}"
+  auth_key    = "${openstack_blockstorage_volume_transfer_v3.transfer_1.auth_key}"
+
+  depends_on = ["openstack_identity_role_assignment_v3.role_assignment_1"]
+}
+`, testAccBlockStorageV3VolumeTransferBasic, projectName, userName)
+}
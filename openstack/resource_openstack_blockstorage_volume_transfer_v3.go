@@ -0,0 +1,178 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferV3Create,
+		Read:   resourceBlockStorageVolumeTransferV3Read,
+		Delete: resourceBlockStorageVolumeTransferV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"auth_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	createOpts := volumetransfers.CreateOpts{
+		VolumeID: volumeID,
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 create options: %#v", createOpts)
+
+	transfer, err := volumetransfers.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_volume_transfer_v3: %s", err)
+	}
+
+	d.SetId(transfer.ID)
+
+	// The auth key is only returned on creation.
+	d.Set("auth_key", transfer.AuthKey)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available"},
+		Target:     []string{"awaiting-transfer"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to await transfer: %s", volumeID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transfer, err := volumetransfers.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving openstack_blockstorage_volume_transfer_v3 %s: %s", d.Id(), err)
+		}
+
+		// The transfer record is removed once the transfer is accepted or
+		// deleted. An accepted transfer moves the volume to the target
+		// project, so decide by the ownership of the volume.
+		accepted, err := blockStorageVolumeTransferV3Accepted(blockStorageClient, d.Get("volume_id").(string))
+		if err != nil {
+			return fmt.Errorf("Error retrieving openstack_blockstorage_volume_transfer_v3 %s volume: %s", d.Id(), err)
+		}
+
+		if !accepted {
+			log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 %s not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 %s was accepted", d.Id())
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_transfer_v3 %s: %#v", d.Id(), transfer)
+
+	d.Set("volume_id", transfer.VolumeID)
+	d.Set("name", transfer.Name)
+	d.Set("created_at", transfer.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := volumetransfers.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_transfer_v3")
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"awaiting-transfer"},
+		Target:     []string{"available", "deleted"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to become available: %s", volumeID, err)
+	}
+
+	return nil
+}
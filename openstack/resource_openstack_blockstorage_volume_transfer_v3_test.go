@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTransfer_basic(t *testing.T) {
+	var transfer volumetransfers.Transfer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTransferExists(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", &transfer),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "name", "transfer_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "auth_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTransferDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_transfer_v3" {
+			continue
+		}
+
+		_, err := volumetransfers.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume transfer still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTransferExists(n string, transfer *volumetransfers.Transfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := volumetransfers.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume transfer not found")
+		}
+
+		*transfer = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeTransferBasic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_transfer_v3" "transfer_1" {
+  name      = "transfer_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageVolumeTypeAccessV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeAccessV3Create,
+		Read:   resourceBlockStorageVolumeTypeAccessV3Read,
+		Delete: resourceBlockStorageVolumeTypeAccessV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID := d.Get("volume_type_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	accessOpts := volumetypes.AddAccessOpts{
+		Project: projectID,
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_type_access_v3 add options: %#v", accessOpts)
+
+	if err := volumetypes.AddAccess(blockStorageClient, volumeTypeID, accessOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("Error adding access to project %s for openstack_blockstorage_volume_type_v3 %s: %s", projectID, volumeTypeID, err)
+	}
+
+	id := fmt.Sprintf("%s/%s", volumeTypeID, projectID)
+	d.SetId(id)
+
+	return resourceBlockStorageVolumeTypeAccessV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID, projectID, err := parseBlockStorageVolumeTypeAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	access, err := getBlockStorageVolumeTypeAccess(blockStorageClient, volumeTypeID, projectID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_type_access_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_type_access_v3 %s: %#v", d.Id(), access)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("volume_type_id", access.VolumeTypeID)
+	d.Set("project_id", access.ProjectID)
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID, projectID, err := parseBlockStorageVolumeTypeAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	removeAccessOpts := volumetypes.RemoveAccessOpts{
+		Project: projectID,
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_type_access_v3 remove options: %#v", removeAccessOpts)
+
+	if err := volumetypes.RemoveAccess(blockStorageClient, volumeTypeID, removeAccessOpts).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_type_access_v3")
+	}
+
+	return nil
+}
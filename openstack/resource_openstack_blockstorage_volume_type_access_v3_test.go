@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+)
+
+func TestAccBlockStorageV3VolumeTypeAccess_basic(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+	var volumeTypeName = acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	var project projects.Project
+	var projectName = acctest.RandomWithPrefix("tf-acc-project")
+
+	var access volumetypes.VolumeTypeAccess
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeAccessBasic(volumeTypeName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					testAccCheckBlockStorageV3VolumeTypeAccessExists("openstack_blockstorage_volume_type_access_v3.access_1", &access),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_type_access_v3.access_1", "volume_type_id", &volumeType.ID),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_type_access_v3.access_1", "project_id", &project.ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_access_v3" {
+			continue
+		}
+
+		volumeTypeID, projectID, err := parseBlockStorageVolumeTypeAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getBlockStorageVolumeTypeAccess(blockStorageClient, volumeTypeID, projectID)
+		if err == nil {
+			return fmt.Errorf("Volume type access still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeAccessExists(n string, access *volumetypes.VolumeTypeAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		volumeTypeID, projectID, err := parseBlockStorageVolumeTypeAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := getBlockStorageVolumeTypeAccess(blockStorageClient, volumeTypeID, projectID)
+		if err != nil {
+			return err
+		}
+
+		*access = found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3VolumeTypeAccessBasic(volumeTypeName, projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name      = "%s"
+  is_public = false
+}
+
+resource "openstack_blockstorage_volume_type_access_v3" "access_1" {
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+  project_id     = "${openstack_identity_project_v3.project_1.id}"
+}
+`, projectName, volumeTypeName)
+}
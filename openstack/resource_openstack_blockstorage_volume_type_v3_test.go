@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func TestAccBlockStorageV3VolumeType_basic(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+	var volumeTypeName = acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeBasic(volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", volumeTypeName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "description", "foo"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "true"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeTypeUpdate(volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", volumeTypeName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "description", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3VolumeType_extraSpecs(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+	var volumeTypeName = acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeExtraSpecs1(volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.capabilities", "gpu"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.volume_backend_name", "ssd"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeTypeExtraSpecs2(volumeTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.volume_backend_name", "hdd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_v3" {
+			continue
+		}
+
+		_, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume type still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeExists(n string, volumeType *volumetypes.VolumeType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume type not found")
+		}
+
+		*volumeType = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3VolumeTypeBasic(volumeTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name        = "%s"
+  description = "foo"
+  is_public   = true
+}
+`, volumeTypeName)
+}
+
+func testAccBlockStorageV3VolumeTypeUpdate(volumeTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name        = "%s"
+  description = "bar"
+  is_public   = false
+}
+`, volumeTypeName)
+}
+
+func testAccBlockStorageV3VolumeTypeExtraSpecs1(volumeTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+
+  extra_specs = {
+    capabilities        = "gpu"
+    volume_backend_name = "ssd"
+  }
+}
+`, volumeTypeName)
+}
+
+func testAccBlockStorageV3VolumeTypeExtraSpecs2(volumeTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+
+  extra_specs = {
+    volume_backend_name = "hdd"
+  }
+}
+`, volumeTypeName)
+}
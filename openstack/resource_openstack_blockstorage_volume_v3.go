@@ -11,8 +11,10 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceBlockStorageVolumeV3() *schema.Resource {
@@ -27,6 +29,7 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -92,13 +95,26 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"volume_type": {
+			"backup_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 
+			"migration_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"never", "on-demand",
+				}, false),
+			},
+
 			"consistency_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -175,6 +191,13 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				Set: blockStorageExtensionsSchedulerHintsHash,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Recreate the volume on a volume_type change unless retyping is allowed.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return blockStorageVolumeV3VolumeTypeCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -199,6 +222,22 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 		SourceVolID:        d.Get("source_vol_id").(string),
 		VolumeType:         d.Get("volume_type").(string),
 		Multiattach:        d.Get("multiattach").(bool),
+		BackupID:           d.Get("backup_id").(string),
+	}
+
+	// Creating a volume from a backup requires the 3.47 microversion.
+	if volumeCreateOpts.BackupID != "" {
+		supported, err := blockStorageV3MicroversionSupported(config, blockStorageClient, blockStorageVolumeV3BackupMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack block storage API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Creating an openstack_blockstorage_volume_v3 from a backup requires microversion %s, "+
+				"which isn't supported by the block storage API", blockStorageVolumeV3BackupMicroversion)
+		}
+
+		blockStorageClient.Microversion = blockStorageVolumeV3BackupMicroversion
 	}
 
 	var createOpts schedulerhints.CreateOptsExt
@@ -222,7 +261,7 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"downloading", "creating"},
+		Pending:    []string{"downloading", "creating", "restoring-backup"},
 		Target:     []string{"available"},
 		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, v.ID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -338,6 +377,47 @@ func resourceBlockStorageVolumeV3Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		changeTypeOpts := volumeactions.ChangeTypeOpts{
+			NewType:         d.Get("volume_type").(string),
+			MigrationPolicy: volumeactions.MigrationPolicy(d.Get("migration_policy").(string)),
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_volume_v3 %s change type options: %#v", d.Id(), changeTypeOpts)
+
+		err = volumeactions.ChangeType(blockStorageClient, d.Id(), changeTypeOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error changing openstack_blockstorage_volume_v3 %s type: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"retyping"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for openstack_blockstorage_volume_v3 %s to become ready: %s", d.Id(), err)
+		}
+
+		// A rejected or rolled back retype ends in the same states, so verify
+		// the resulting volume type.
+		v, err := volumes.Get(blockStorageClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+		}
+
+		if v.VolumeType != changeTypeOpts.NewType {
+			return fmt.Errorf(
+				"Error changing openstack_blockstorage_volume_v3 %s type: expected %q, got %q", d.Id(), changeTypeOpts.NewType, v.VolumeType)
+		}
+	}
+
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
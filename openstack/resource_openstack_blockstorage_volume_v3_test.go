@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
@@ -128,6 +129,43 @@ func TestAccBlockStorageV3Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV3Volume_retype(t *testing.T) {
+	var volume volumes.Volume
+	var retypedVolume volumes.Volume
+	volumeType1 := acctest.RandomWithPrefix("tf-acc-volume-type")
+	volumeType2 := acctest.RandomWithPrefix("tf-acc-volume-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeRetype(volumeType1, volumeType2, "volume_type_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeRetype(volumeType1, volumeType2, "volume_type_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &retypedVolume),
+					testAccCheckBlockStorageV3VolumeSameID(&volume, &retypedVolume),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type",
+						"openstack_blockstorage_volume_type_v3.volume_type_2", "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageV3VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.BlockStorageV3Client(osRegionName)
@@ -181,6 +219,16 @@ func testAccCheckBlockStorageV3VolumeExists(n string, volume *volumes.Volume) re
 	}
 }
 
+func testAccCheckBlockStorageV3VolumeSameID(before, after *volumes.Volume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("Volume was recreated: %s != %s", before.ID, after.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBlockStorageV3VolumeMetadata(
 	volume *volumes.Volume, k string, v string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -303,3 +351,22 @@ resource "openstack_blockstorage_volume_v3" "volume_1" {
   }
 }
 `
+
+func testAccBlockStorageV3VolumeRetype(volumeType1, volumeType2, volumeType string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name             = "volume_1"
+  size             = 1
+  volume_type      = "${openstack_blockstorage_volume_type_v3.%s.name}"
+  migration_policy = "on-demand"
+}
+`, volumeType1, volumeType2, volumeType)
+}
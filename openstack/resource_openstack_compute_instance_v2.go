@@ -3,6 +3,7 @@ package openstack
 import (
 	"bytes"
 	"crypto/sha1"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"log"
@@ -14,17 +15,23 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/pauseunpause"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/rescueunrescue"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/shelveunshelve"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/suspendresume"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	flavors_utils "github.com/gophercloud/utils/openstack/compute/v2/flavors"
 	images_utils "github.com/gophercloud/utils/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -63,13 +70,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"image_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"image_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"flavor_id": {
@@ -93,7 +98,6 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				// just stash the hash for state & diff comparisons
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
@@ -223,7 +227,29 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"key_pair": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+			},
+			"rebuild_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rebuild_preserve_ephemeral": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requested_host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"migration_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"live", "cold", "block",
+				}, false),
 			},
 			"block_device": {
 				Type:     schema.TypeList,
@@ -398,7 +424,7 @@ func resourceComputeInstanceV2() *schema.Resource {
 				ForceNew: false,
 				Default:  "active",
 				ValidateFunc: validation.StringInSlice([]string{
-					"active", "shutoff",
+					"active", "shutoff", "shelved", "shelved_offloaded", "suspended", "paused",
 				}, true),
 				DiffSuppressFunc: suppressPowerStateDiffs,
 			},
@@ -412,6 +438,61 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"rescue": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rescue_image_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"admin_pass": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"resize_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "confirm",
+							ValidateFunc: validation.StringInSlice([]string{
+								"confirm", "manual", "revert_on_failure",
+							}, false),
+						},
+						"verify_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30m",
+							ValidateFunc: validateComputeV2InstanceDuration,
+						},
+						"timeout_action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "revert",
+							ValidateFunc: validation.StringInSlice([]string{
+								"confirm", "revert",
+							}, false),
+						},
+						"check_quota": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"vendor_options": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -433,6 +514,25 @@ func resourceComputeInstanceV2() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Recreate the instance on an image change unless rebuilding is allowed.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeV2InstanceRebuildCustomizeDiff(diff)
+			},
+			// Reject a resize_policy, which conflicts with ignore_resize_confirmation.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeV2InstanceResizePolicyCustomizeDiff(diff)
+			},
+			// Reject resizes, which would exceed the compute quota.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeV2InstanceResizeQuotaCustomizeDiff(diff, v)
+			},
+			// Refresh the actual host when a migration is requested.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeV2InstanceMigrationCustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -595,25 +695,23 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			server.ID, err)
 	}
 
-	vmState := d.Get("power_state").(string)
-	if strings.ToLower(vmState) == "shutoff" {
-		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
+	if requestedHost := d.Get("requested_host").(string); requestedHost != "" {
+		err = computeV2InstanceMigrate(config, computeClient, d, requestedHost, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf("Error stopping OpenStack instance: %s", err)
-		}
-		stopStateConf := &resource.StateChangeConf{
-			//Pending:    []string{"ACTIVE"},
-			Target:     []string{"SHUTOFF"},
-			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+			return err
 		}
+	}
+
+	vmState := d.Get("power_state").(string)
+	err = computeV2InstanceSetPowerState(computeClient, d, "active", vmState, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
 
-		log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-		_, err = stopStateConf.WaitForState()
+	if rescue := d.Get("rescue").([]interface{}); len(rescue) > 0 {
+		err = computeV2InstanceRescue(computeClient, d, rescue, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf("Error waiting for instance (%s) to become inactive(shutoff): %s", d.Id(), err)
+			return err
 		}
 	}
 
@@ -702,10 +800,11 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	// Build a custom struct for the availability zone extension
+	// Build a custom struct for the availability zone and host extensions
 	var serverWithAZ struct {
 		servers.Server
 		availabilityzones.ServerAvailabilityZoneExt
+		extendedserverattributes.ServerAttributesExt
 	}
 
 	// Do another Get so the above work is not disturbed.
@@ -716,18 +815,31 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	// Set the availability zone
 	d.Set("availability_zone", serverWithAZ.AvailabilityZone)
 
+	// Set the host, which is only visible to administrators
+	d.Set("host", serverWithAZ.Host)
+
 	// Set the region
 	d.Set("region", GetRegion(d, config))
 
 	// Set the current power_state
 	currentStatus := strings.ToLower(server.Status)
 	switch currentStatus {
-	case "active", "shutoff", "error", "migrating", "shelved_offloaded", "shelved":
+	case "active", "shutoff", "error", "migrating", "shelved_offloaded", "shelved", "suspended", "paused":
 		d.Set("power_state", currentStatus)
+	case "rescue":
+		// A rescued instance keeps its power_state.
 	default:
 		return fmt.Errorf("Invalid power_state for instance %s: %s", d.Id(), server.Status)
 	}
 
+	// Keep the rescue block in sync with the actual rescue state.
+	rescue := d.Get("rescue").([]interface{})
+	if currentStatus == "rescue" && len(rescue) == 0 {
+		d.Set("rescue", []map[string]interface{}{{}})
+	} else if currentStatus != "rescue" && len(rescue) > 0 {
+		d.Set("rescue", nil)
+	}
+
 	// Populate tags.
 	computeClient.Microversion = computeV2TagsExtensionMicroversion
 	instanceTags, err := tags.List(computeClient, server.ID).Extract()
@@ -759,58 +871,38 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("power_state") {
-		powerStateOldRaw, powerStateNewRaw := d.GetChange("power_state")
-		powerStateOld := powerStateOldRaw.(string)
-		powerStateNew := powerStateNewRaw.(string)
-		if strings.ToLower(powerStateNew) == "shutoff" {
-			err = startstop.Stop(computeClient, d.Id()).ExtractErr()
+	if d.HasChange("requested_host") {
+		if requestedHost := d.Get("requested_host").(string); requestedHost != "" {
+			err = computeV2InstanceMigrate(config, computeClient, d, requestedHost, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return fmt.Errorf("Error stopping OpenStack instance: %s", err)
-			}
-			stopStateConf := &resource.StateChangeConf{
-				//Pending:    []string{"ACTIVE"},
-				Target:     []string{"SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
+				return err
 			}
+		}
+	}
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-			_, err = stopStateConf.WaitForState()
+	// An instance has to be unrescued before its power state can be changed.
+	if d.HasChange("rescue") {
+		if oldRescue, _ := d.GetChange("rescue"); len(oldRescue.([]interface{})) > 0 {
+			err = computeV2InstanceUnrescue(computeClient, d, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to become inactive(shutoff): %s", d.Id(), err)
+				return err
 			}
 		}
-		if strings.ToLower(powerStateNew) == "active" {
-			if strings.ToLower(powerStateOld) == "shelved" || strings.ToLower(powerStateOld) == "shelved_offloaded" {
-				unshelveOpt := &shelveunshelve.UnshelveOpts{
-					AvailabilityZone: d.Get("availability_zone").(string),
-				}
-				err = shelveunshelve.Unshelve(computeClient, d.Id(), unshelveOpt).ExtractErr()
-				if err != nil {
-					return fmt.Errorf("Error unshelving OpenStack instance: %s", err)
-				}
-			} else {
-				err = startstop.Start(computeClient, d.Id()).ExtractErr()
-				if err != nil {
-					return fmt.Errorf("Error starting OpenStack instance: %s", err)
-				}
-			}
-			startStateConf := &resource.StateChangeConf{
-				//Pending:    []string{"SHUTOFF"},
-				Target:     []string{"ACTIVE"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
+	}
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to start/unshelve", d.Id())
-			_, err = startStateConf.WaitForState()
+	if d.HasChange("power_state") {
+		powerStateOldRaw, powerStateNewRaw := d.GetChange("power_state")
+		err = computeV2InstanceSetPowerState(computeClient, d, powerStateOldRaw.(string), powerStateNewRaw.(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("rescue") {
+		if _, newRescue := d.GetChange("rescue"); len(newRescue.([]interface{})) > 0 {
+			err = computeV2InstanceRescue(computeClient, d, newRescue.([]interface{}), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to become active: %s", d.Id(), err)
+				return err
 			}
 		}
 	}
@@ -885,6 +977,13 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChanges("image_id", "image_name", "user_data", "key_pair") {
+		err = computeV2InstanceRebuild(config, computeClient, d, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("admin_pass") {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := servers.ChangeAdminPassword(computeClient, d.Id(), newPwd).ExtractErr()
@@ -915,68 +1014,9 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 			}
 		}
 
-		resizeOpts := &servers.ResizeOpts{
-			FlavorRef: newFlavorID,
-		}
-		log.Printf("[DEBUG] Resize configuration: %#v", resizeOpts)
-		err = servers.Resize(computeClient, d.Id(), resizeOpts).ExtractErr()
+		err = computeV2InstanceResize(computeClient, d, newFlavorID, ignoreResizeConfirmation, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("Error resizing OpenStack server: %s", err)
-		}
-
-		// Wait for the instance to finish resizing.
-		log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", d.Id())
-
-		// Resize instance without confirmation if specified by user.
-		if ignoreResizeConfirmation {
-			stateConf := &resource.StateChangeConf{
-				Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
-				Target:     []string{"ACTIVE", "SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-
-			_, err = stateConf.WaitForState()
-			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
-			}
-		} else {
-			stateConf := &resource.StateChangeConf{
-				Pending:    []string{"RESIZE"},
-				Target:     []string{"VERIFY_RESIZE"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-
-			_, err = stateConf.WaitForState()
-			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
-			}
-
-			// Confirm resize.
-			log.Printf("[DEBUG] Confirming resize")
-			err = servers.ConfirmResize(computeClient, d.Id()).ExtractErr()
-			if err != nil {
-				return fmt.Errorf("Error confirming resize of OpenStack server: %s", err)
-			}
-
-			stateConf = &resource.StateChangeConf{
-				Pending:    []string{"VERIFY_RESIZE"},
-				Target:     []string{"ACTIVE", "SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-
-			_, err = stateConf.WaitForState()
-			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", d.Id(), err)
-			}
+			return err
 		}
 	}
 
@@ -1179,6 +1219,538 @@ func ServerV2StateRefreshFunc(client *gophercloud.ServiceClient, instanceID stri
 	}
 }
 
+// computeV2InstanceSetPowerState moves an instance from its old power state
+// to the new one. Shelving is possible from any running or stopped state,
+// all other states are reached through the active state.
+func computeV2InstanceSetPowerState(client *gophercloud.ServiceClient, d *schema.ResourceData, oldState, newState string, timeout time.Duration) error {
+	oldState = strings.ToLower(oldState)
+	newState = strings.ToLower(newState)
+
+	if oldState == newState {
+		return nil
+	}
+
+	if newState == "shelved" || newState == "shelved_offloaded" {
+		status := strings.ToUpper(oldState)
+
+		if oldState == "shelved_offloaded" {
+			if err := computeV2InstanceUnshelve(client, d, timeout); err != nil {
+				return err
+			}
+			status = "ACTIVE"
+		}
+
+		if oldState != "shelved" {
+			err := shelveunshelve.Shelve(client, d.Id()).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error shelving OpenStack instance: %s", err)
+			}
+
+			// The instance may be offloaded immediately, depending on the
+			// shelved_offload_time setting of the Compute service.
+			status, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"SHELVED", "SHELVED_OFFLOADED"}, timeout)
+			if err != nil {
+				return err
+			}
+		}
+
+		if newState == "shelved_offloaded" && status != "SHELVED_OFFLOADED" {
+			err := shelveunshelve.ShelveOffload(client, d.Id()).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error offloading OpenStack instance: %s", err)
+			}
+
+			_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"SHELVED_OFFLOADED"}, timeout)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var err error
+	switch oldState {
+	case "shutoff":
+		err = startstop.Start(client, d.Id()).ExtractErr()
+	case "paused":
+		err = pauseunpause.Unpause(client, d.Id()).ExtractErr()
+	case "suspended":
+		err = suspendresume.Resume(client, d.Id()).ExtractErr()
+	case "shelved", "shelved_offloaded":
+		err = computeV2InstanceUnshelve(client, d, timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("Error changing OpenStack instance power state from %s: %s", oldState, err)
+	}
+
+	if oldState != "active" {
+		_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"ACTIVE"}, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	var target string
+	switch newState {
+	case "shutoff":
+		target = "SHUTOFF"
+		err = startstop.Stop(client, d.Id()).ExtractErr()
+	case "paused":
+		target = "PAUSED"
+		err = pauseunpause.Pause(client, d.Id()).ExtractErr()
+	case "suspended":
+		target = "SUSPENDED"
+		err = suspendresume.Suspend(client, d.Id()).ExtractErr()
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error changing OpenStack instance power state to %s: %s", newState, err)
+	}
+
+	_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{target}, timeout)
+
+	return err
+}
+
+func computeV2InstanceUnshelve(client *gophercloud.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	unshelveOpt := &shelveunshelve.UnshelveOpts{
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+	err := shelveunshelve.Unshelve(client, d.Id(), unshelveOpt).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error unshelving OpenStack instance: %s", err)
+	}
+
+	_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"ACTIVE"}, timeout)
+
+	return err
+}
+
+// computeV2InstanceStatuses lists the statuses an instance may pass through
+// during an action. ERROR and DELETED aren't listed, so that they abort the
+// wait immediately.
+var computeV2InstanceStatuses = []string{
+	"ACTIVE",
+	"BUILD",
+	"HARD_REBOOT",
+	"MIGRATING",
+	"PASSWORD",
+	"PAUSED",
+	"REBOOT",
+	"REBUILD",
+	"RESCUE",
+	"RESIZE",
+	"REVERT_RESIZE",
+	"SHELVED",
+	"SHELVED_OFFLOADED",
+	"SHUTOFF",
+	"SUSPENDED",
+	"VERIFY_RESIZE",
+}
+
+func computeV2InstanceWaitForStatus(client *gophercloud.ServiceClient, instanceID string, target []string, timeout time.Duration) (string, error) {
+	var pending []string
+	for _, status := range computeV2InstanceStatuses {
+		if !strSliceContains(target, status) {
+			pending = append(pending, status)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to become %s", instanceID, strings.Join(target, " or "))
+	s, err := stateConf.WaitForState()
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for instance (%s) to become %s: %s", instanceID, strings.Join(target, " or "), err)
+	}
+
+	return s.(*servers.Server).Status, nil
+}
+
+// computeV2InstanceRescue boots an instance into rescue mode and stores the
+// admin password of the rescue system.
+func computeV2InstanceRescue(client *gophercloud.ServiceClient, d *schema.ResourceData, rescue []interface{}, timeout time.Duration) error {
+	rescueOpts := expandComputeV2InstanceRescueOpts(rescue)
+
+	log.Printf("[DEBUG] Rescuing openstack_compute_instance_v2 %s with image %s", d.Id(), rescueOpts.RescueImageRef)
+	adminPass, err := rescueunrescue.Rescue(client, d.Id(), rescueOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error rescuing OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"RESCUE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.Set("rescue", []map[string]interface{}{
+		{
+			"rescue_image_id": rescueOpts.RescueImageRef,
+			"admin_pass":      adminPass,
+		},
+	})
+
+	return nil
+}
+
+// computeV2InstanceUnrescue boots a rescued instance from its own disk again.
+func computeV2InstanceUnrescue(client *gophercloud.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	log.Printf("[DEBUG] Unrescuing openstack_compute_instance_v2 %s", d.Id())
+	err := rescueunrescue.Unrescue(client, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error unrescuing OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"ACTIVE"}, timeout)
+
+	return err
+}
+
+// computeV2InstanceMigrate moves an instance to the requested host using the
+// configured migration_mode and waits until it is running there.
+func computeV2InstanceMigrate(config *Config, client *gophercloud.ServiceClient, d *schema.ResourceData, host string, timeout time.Duration) error {
+	var server struct {
+		servers.Server
+		extendedserverattributes.ServerAttributesExt
+	}
+
+	err := servers.Get(client, d.Id()).ExtractInto(&server)
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	if server.Host == host {
+		log.Printf("[DEBUG] openstack_compute_instance_v2 %s is already on host %s", d.Id(), host)
+		return nil
+	}
+
+	mode := d.Get("migration_mode").(string)
+
+	log.Printf("[DEBUG] Migrating openstack_compute_instance_v2 %s to host %s using %s migration", d.Id(), host, mode)
+
+	var supported bool
+	switch mode {
+	case "cold":
+		supported, err = computeV2MicroversionSupported(config, client, computeV2InstanceColdMigrateHostMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("A cold migration of openstack_compute_instance_v2 %s to a host requires microversion %s, "+
+				"which isn't supported by the compute API", d.Id(), computeV2InstanceColdMigrateHostMicroversion)
+		}
+
+		microversion := client.Microversion
+		client.Microversion = computeV2InstanceColdMigrateHostMicroversion
+		err = computeV2InstanceColdMigrate(client, d.Id(), host)
+		client.Microversion = microversion
+	default:
+		supported, err = computeV2MicroversionSupported(config, client, computeV2InstanceLiveMigrateAutoMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		liveMigrateOpts := computeV2InstanceLiveMigrateOpts{
+			Host:           host,
+			BlockMigration: mode == "block",
+		}
+
+		microversion := client.Microversion
+		if supported {
+			client.Microversion = computeV2InstanceLiveMigrateAutoMicroversion
+			if mode != "block" {
+				liveMigrateOpts.BlockMigration = "auto"
+			}
+		} else {
+			// disk_over_commit is required before microversion 2.25.
+			diskOverCommit := false
+			liveMigrateOpts.DiskOverCommit = &diskOverCommit
+		}
+
+		err = computeV2InstanceLiveMigrate(client, d.Id(), liveMigrateOpts)
+		client.Microversion = microversion
+	}
+	if err != nil {
+		return fmt.Errorf("Error migrating OpenStack instance %s to host %s: %s", d.Id(), host, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"MIGRATING", "RESIZE"},
+		Target:     []string{"MIGRATED", "VERIFY_RESIZE"},
+		Refresh:    computeV2InstanceMigrationStateRefreshFunc(client, d.Id(), host),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	state, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to migrate to host %s: %s", d.Id(), host, err)
+	}
+
+	// A cold migration has to be confirmed like a resize.
+	if state.(*servers.Server).Status == "VERIFY_RESIZE" {
+		err = servers.ConfirmResize(client, d.Id()).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error confirming migration of OpenStack instance %s: %s", d.Id(), err)
+		}
+
+		stateConf.Pending = []string{"VERIFY_RESIZE"}
+		stateConf.Target = []string{"MIGRATED"}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to migrate to host %s: %s", d.Id(), host, err)
+		}
+	}
+
+	return nil
+}
+
+// computeV2InstanceResize resizes an instance to the given flavor and
+// confirms or reverts the resize according to the resize_policy.
+func computeV2InstanceResize(client *gophercloud.ServiceClient, d *schema.ResourceData, flavorID string, ignoreResizeConfirmation bool, timeout time.Duration) error {
+	policy := expandComputeV2InstanceResizePolicy(d.Get("resize_policy").([]interface{}))
+
+	var oldServer struct {
+		servers.Server
+		extendedstatus.ServerExtendedStatusExt
+	}
+	err := servers.Get(client, d.Id()).ExtractInto(&oldServer)
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack server %s: %s", d.Id(), err)
+	}
+
+	resizeOpts := &servers.ResizeOpts{
+		FlavorRef: flavorID,
+	}
+	log.Printf("[DEBUG] Resize configuration: %#v", resizeOpts)
+	err = servers.Resize(client, d.Id(), resizeOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error resizing OpenStack server: %s", err)
+	}
+
+	// Wait for the instance to finish resizing.
+	log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", d.Id())
+
+	// Resize instance without confirmation if specified by user.
+	if ignoreResizeConfirmation {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
+			Target:     []string{"ACTIVE", "SHUTOFF"},
+			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RESIZE"},
+		Target:     []string{"VERIFY_RESIZE"},
+		Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
+	}
+
+	switch policy.Mode {
+	case "manual":
+		return computeV2InstanceWaitForResizeVerification(client, d.Id(), flavorID, policy, timeout)
+	case "revert_on_failure":
+		var server struct {
+			servers.Server
+			extendedstatus.ServerExtendedStatusExt
+		}
+		err = servers.Get(client, d.Id()).ExtractInto(&server)
+		if err != nil {
+			return fmt.Errorf("Error retrieving OpenStack server %s: %s", d.Id(), err)
+		}
+
+		// A running instance has to be running again after the resize.
+		if oldServer.PowerState == extendedstatus.RUNNING && server.PowerState != extendedstatus.RUNNING {
+			log.Printf("[DEBUG] Instance (%s) is %s after the resize, reverting", d.Id(), server.PowerState)
+			err = computeV2InstanceFinishResize(client, d.Id(), "revert", timeout)
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resize of instance (%s) was reverted, the power state was %s after the resize", d.Id(), server.PowerState)
+		}
+	}
+
+	return computeV2InstanceFinishResize(client, d.Id(), "confirm", timeout)
+}
+
+// computeV2InstanceWaitForResizeVerification waits for the resize of an
+// instance to be confirmed or reverted outside of Terraform. When the
+// verify_timeout expires, the timeout_action of the resize_policy is applied.
+func computeV2InstanceWaitForResizeVerification(client *gophercloud.ServiceClient, instanceID, flavorID string, policy computeV2InstanceResizePolicy, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"VERIFY_RESIZE", "REVERT_RESIZE", "RESIZE"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:    policy.VerifyTimeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting %s for the resize of instance (%s) to be verified", policy.VerifyTimeout, instanceID)
+	s, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			return fmt.Errorf("Error waiting for the resize of instance (%s) to be verified: %s", instanceID, err)
+		}
+
+		log.Printf("[DEBUG] The resize of instance (%s) was not verified in time, applying %s", instanceID, policy.TimeoutAction)
+		err = computeV2InstanceFinishResize(client, instanceID, policy.TimeoutAction, timeout)
+		if err != nil {
+			return err
+		}
+
+		if policy.TimeoutAction == "revert" {
+			return fmt.Errorf("Resize of instance (%s) was reverted, it was not verified within %s", instanceID, policy.VerifyTimeout)
+		}
+
+		return nil
+	}
+
+	if newFlavorID, ok := s.(*servers.Server).Flavor["id"].(string); ok && newFlavorID != flavorID {
+		return fmt.Errorf("Resize of instance (%s) was reverted", instanceID)
+	}
+
+	return nil
+}
+
+// computeV2InstanceFinishResize confirms or reverts the resize of an
+// instance and waits for it to finish.
+func computeV2InstanceFinishResize(client *gophercloud.ServiceClient, instanceID, action string, timeout time.Duration) error {
+	var err error
+	if action == "revert" {
+		log.Printf("[DEBUG] Reverting resize")
+		err = servers.RevertResize(client, instanceID).ExtractErr()
+	} else {
+		log.Printf("[DEBUG] Confirming resize")
+		err = servers.ConfirmResize(client, instanceID).ExtractErr()
+	}
+	if err != nil {
+		return fmt.Errorf("Error finishing resize of OpenStack server with %s: %s", action, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"VERIFY_RESIZE", "REVERT_RESIZE"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to %s resize: %s", instanceID, action, err)
+	}
+
+	return nil
+}
+
+// computeV2InstanceRebuild rebuilds an instance with its current image,
+// keeping the same server ID and network ports.
+func computeV2InstanceRebuild(config *Config, client *gophercloud.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	imageID := d.Get("image_id").(string)
+	if d.HasChange("image_name") && !d.HasChange("image_id") {
+		var err error
+		imageID, err = images_utils.IDFromName(client, d.Get("image_name").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	rebuildOpts := &ServerRebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageRef:  imageID,
+			AdminPass: d.Get("admin_pass").(string),
+		},
+	}
+
+	if d.Get("rebuild_preserve_ephemeral").(bool) {
+		preserveEphemeral := true
+		rebuildOpts.PreserveEphemeral = &preserveEphemeral
+	}
+
+	// Only the hash of user_data is stored in the state,
+	// so it can only be injected when it has changed.
+	microversion := client.Microversion
+	if d.HasChange("user_data") {
+		supported, err := computeV2MicroversionSupported(config, client, computeV2InstanceRebuildUserDataMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Rebuilding openstack_compute_instance_v2 %s with new user_data requires microversion %s, "+
+				"which isn't supported by the compute API", d.Id(), computeV2InstanceRebuildUserDataMicroversion)
+		}
+
+		if userData := d.Get("user_data").(string); userData != "" {
+			rebuildOpts.UserData = base64.StdEncoding.EncodeToString([]byte(userData))
+		}
+		client.Microversion = computeV2InstanceRebuildUserDataMicroversion
+	}
+
+	// The key pair is kept by the rebuild, unless a new one is passed.
+	if d.HasChange("key_pair") {
+		supported, err := computeV2MicroversionSupported(config, client, computeV2InstanceRebuildKeyNameMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Rebuilding openstack_compute_instance_v2 %s with a new key_pair requires microversion %s, "+
+				"which isn't supported by the compute API", d.Id(), computeV2InstanceRebuildKeyNameMicroversion)
+		}
+
+		rebuildOpts.KeyName = d.Get("key_pair").(string)
+		if client.Microversion != computeV2InstanceRebuildUserDataMicroversion {
+			client.Microversion = computeV2InstanceRebuildKeyNameMicroversion
+		}
+	}
+
+	log.Printf("[DEBUG] Rebuilding openstack_compute_instance_v2 %s with image %s", d.Id(), imageID)
+
+	_, err := servers.Rebuild(client, d.Id(), rebuildOpts).Extract()
+	client.Microversion = microversion
+	if err != nil {
+		return fmt.Errorf("Error rebuilding OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	// A stopped instance stays stopped after the rebuild.
+	_, err = computeV2InstanceWaitForStatus(client, d.Id(), []string{"ACTIVE", "SHUTOFF"}, timeout)
+
+	return err
+}
+
 func resourceInstanceSecGroupsV2(d *schema.ResourceData) []string {
 	rawSecGroups := d.Get("security_groups").(*schema.Set).List()
 	secgroups := make([]string, len(rawSecGroups))
@@ -1503,5 +2075,10 @@ func suppressPowerStateDiffs(k, old, new string, d *schema.ResourceData) bool {
 		return true
 	}
 
+	// The Compute service may offload shelved instances immediately.
+	if old == "shelved_offloaded" && new == "shelved" {
+		return true
+	}
+
 	return false
 }
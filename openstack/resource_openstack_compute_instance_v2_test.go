@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
@@ -120,6 +121,196 @@ func TestAccComputeV2Instance_initialStateShutoff(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_powerStates(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstancePowerState("paused"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "paused"),
+					testAccCheckComputeV2InstanceState(&instance, "paused"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstancePowerState("suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "suspended"),
+					testAccCheckComputeV2InstanceState(&instance, "suspended"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstancePowerState("shutoff"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shutoff"),
+					testAccCheckComputeV2InstanceState(&instance, "shutoff"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstancePowerState("shelved_offloaded"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shelved_offloaded"),
+					testAccCheckComputeV2InstanceState(&instance, "shelved_offloaded"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstancePowerState("active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rebuildOnImageChange(t *testing.T) {
+	var instance servers.Server
+	var rebuiltInstance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceRebuild(osImageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "image_id", osImageID),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceRebuild("${openstack_images_image_v2.image_1.id}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &rebuiltInstance),
+					testAccCheckComputeV2InstanceSameID(&instance, &rebuiltInstance),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_v2.instance_1", "image_id",
+						"openstack_images_image_v2.image_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_requestedHost(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckHypervisor(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceRequestedHost(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "host", osHypervisorEnvironment),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_resizePolicy(t *testing.T) {
+	var instance servers.Server
+	var resizedInstance servers.Server
+	flavor1 := acctest.RandomWithPrefix("tf-acc-flavor")
+	flavor2 := acctest.RandomWithPrefix("tf-acc-flavor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceResizePolicy(flavor1, flavor2, "flavor_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_v2.instance_1", "flavor_id",
+						"openstack_compute_flavor_v2.flavor_1", "id"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceResizePolicy(flavor1, flavor2, "flavor_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &resizedInstance),
+					testAccCheckComputeV2InstanceSameID(&instance, &resizedInstance),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_v2.instance_1", "flavor_id",
+						"openstack_compute_flavor_v2.flavor_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rescue(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceRescue(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceState(&instance, "rescue"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "rescue.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "rescue.0.admin_pass", "rescue-pass"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "rescue.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_secgroupMulti(t *testing.T) {
 	var instance1 servers.Server
 	var secgroup1 secgroups.SecurityGroup
@@ -803,6 +994,16 @@ func testAccCheckComputeV2InstanceState(
 	}
 }
 
+func testAccCheckComputeV2InstanceSameID(before, after *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("Instance was recreated: %s != %s", before.ID, after.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceTags(name string, tags []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1575,6 +1776,102 @@ resource "openstack_compute_instance_v2" "instance_1" {
 `, osNetworkID)
 }
 
+func testAccComputeV2InstancePowerState(powerState string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+`, powerState, osNetworkID)
+}
+
+func testAccComputeV2InstanceRebuild(imageID string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_image_v2" "image_1" {
+  name             = "CirrOS-tf_1"
+  image_source_url = "http://download.cirros-cloud.net/0.3.5/cirros-0.3.5-x86_64-disk.img"
+  container_format = "bare"
+  disk_format      = "qcow2"
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name                    = "instance_1"
+  security_groups         = ["default"]
+  image_id                = "%s"
+  rebuild_on_image_change = true
+  network {
+    uuid = "%s"
+  }
+}
+`, imageID, osNetworkID)
+}
+
+func testAccComputeV2InstanceRequestedHost() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  requested_host  = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+`, osHypervisorEnvironment, osNetworkID)
+}
+
+func testAccComputeV2InstanceResizePolicy(flavor1, flavor2, flavor string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_flavor_v2" "flavor_1" {
+  name  = "%s"
+  ram   = 512
+  vcpus = 1
+  disk  = 5
+}
+
+resource "openstack_compute_flavor_v2" "flavor_2" {
+  name  = "%s"
+  ram   = 1024
+  vcpus = 1
+  disk  = 5
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  flavor_id       = "${openstack_compute_flavor_v2.%s.id}"
+  network {
+    uuid = "%s"
+  }
+
+  resize_policy {
+    mode        = "revert_on_failure"
+    check_quota = true
+  }
+}
+`, flavor1, flavor2, flavor, osNetworkID)
+}
+
+func testAccComputeV2InstanceRescue() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+
+  rescue {
+    rescue_image_id = "%s"
+    admin_pass      = "rescue-pass"
+  }
+}
+`, osNetworkID, osImageID)
+}
+
 func testAccComputeV2InstanceTagsCreate() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_instance_v2" "instance_1" {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceComputeKeypairV2() *schema.Resource {
@@ -32,6 +33,44 @@ func resourceComputeKeypairV2() *schema.Resource {
 			},
 
 			"public_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_algorithm"},
+			},
+
+			"key_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ssh", "x509",
+				}, false),
+			},
+
+			"key_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"rsa", "ecdsa", "ed25519",
+				}, false),
+			},
+
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"key_algorithm"},
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{256, 384, 521}),
+					validation.IntAtLeast(2048),
+				),
+			},
+
+			"user_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
@@ -46,8 +85,9 @@ func resourceComputeKeypairV2() *schema.Resource {
 
 			// computed-only
 			"private_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"fingerprint": {
@@ -66,16 +106,40 @@ func resourceComputeKeypairV2Create(d *schema.ResourceData, meta interface{}) er
 	}
 
 	name := d.Get("name").(string)
+	keyType := d.Get("key_type").(string)
+	userID := d.Get("user_id").(string)
+	publicKey := d.Get("public_key").(string)
+
+	// Generate the key locally, only the public key is uploaded.
+	var privateKey string
+	if algorithm := d.Get("key_algorithm").(string); algorithm != "" {
+		if keyType == "x509" {
+			return fmt.Errorf("Unable to create openstack_compute_keypair_v2 %s: key_algorithm can only be used with the ssh key_type", name)
+		}
+
+		privateKey, publicKey, err = computeKeyPairV2GenerateKey(algorithm, d.Get("key_size").(int))
+		if err != nil {
+			return fmt.Errorf("Unable to generate openstack_compute_keypair_v2 %s key: %s", name, err)
+		}
+	}
+
 	createOpts := ComputeKeyPairV2CreateOpts{
 		keypairs.CreateOpts{
 			Name:      name,
-			PublicKey: d.Get("public_key").(string),
+			PublicKey: publicKey,
+			Type:      keyType,
+			UserID:    userID,
 		},
 		MapValueSpecs(d),
 	}
 
 	log.Printf("[DEBUG] openstack_compute_keypair_v2 create options: %#v", createOpts)
 
+	computeClient.Microversion, err = computeKeyPairV2NegotiateMicroversion(config, computeClient, keyType, userID)
+	if err != nil {
+		return fmt.Errorf("Unable to create openstack_compute_keypair_v2 %s: %s", name, err)
+	}
+
 	kp, err := keypairs.Create(computeClient, createOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Unable to create openstack_compute_keypair_v2 %s: %s", name, err)
@@ -84,7 +148,10 @@ func resourceComputeKeypairV2Create(d *schema.ResourceData, meta interface{}) er
 	d.SetId(kp.Name)
 
 	// Private Key is only available in the response to a create.
-	d.Set("private_key", kp.PrivateKey)
+	if privateKey == "" {
+		privateKey = kp.PrivateKey
+	}
+	d.Set("private_key", privateKey)
 
 	return resourceComputeKeypairV2Read(d, meta)
 }
@@ -96,7 +163,17 @@ func resourceComputeKeypairV2Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	kp, err := keypairs.Get(computeClient, d.Id()).Extract()
+	userID := d.Get("user_id").(string)
+	computeClient.Microversion, err = computeKeyPairV2NegotiateMicroversion(config, computeClient, d.Get("key_type").(string), userID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_keypair_v2 %s: %s", d.Id(), err)
+	}
+
+	getOpts := keypairs.GetOpts{
+		UserID: userID,
+	}
+
+	kp, err := keypairs.Get(computeClient, d.Id(), getOpts).Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "Error retrieving openstack_compute_keypair_v2")
 	}
@@ -106,6 +183,14 @@ func resourceComputeKeypairV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("name", kp.Name)
 	d.Set("public_key", kp.PublicKey)
 	d.Set("fingerprint", kp.Fingerprint)
+
+	// The type and the user are only returned with newer microversions.
+	if kp.Type != "" {
+		d.Set("key_type", kp.Type)
+	}
+	if kp.UserID != "" {
+		d.Set("user_id", kp.UserID)
+	}
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -118,7 +203,17 @@ func resourceComputeKeypairV2Delete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	err = keypairs.Delete(computeClient, d.Id()).ExtractErr()
+	userID := d.Get("user_id").(string)
+	computeClient.Microversion, err = computeKeyPairV2NegotiateMicroversion(config, computeClient, d.Get("key_type").(string), userID)
+	if err != nil {
+		return fmt.Errorf("Error deleting openstack_compute_keypair_v2 %s: %s", d.Id(), err)
+	}
+
+	deleteOpts := keypairs.DeleteOpts{
+		UserID: userID,
+	}
+
+	err = keypairs.Delete(computeClient, d.Id(), deleteOpts).ExtractErr()
 	if err != nil {
 		return CheckDeleted(d, err, "Error deleting openstack_compute_keypair_v2")
 	}
@@ -54,6 +54,56 @@ func TestAccComputeV2Keypair_generatePrivate(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Keypair_generateED25519(t *testing.T) {
+	var keypair keypairs.KeyPair
+
+	publicKeyRe := regexp.MustCompile(`^ssh-ed25519 `)
+	privateKeyRe := regexp.MustCompile(`.*BEGIN OPENSSH PRIVATE KEY.*`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2KeypairGenerateED25519,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("openstack_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_keypair_v2.kp_1", "key_type", "ssh"),
+					resource.TestMatchResourceAttr(
+						"openstack_compute_keypair_v2.kp_1", "public_key", publicKeyRe),
+					resource.TestMatchResourceAttr(
+						"openstack_compute_keypair_v2.kp_1", "private_key", privateKeyRe),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Keypair_userID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2KeypairUserID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_keypair_v2.kp_1", "user_id",
+						"openstack_identity_user_v3.user_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_keypair_v2.kp_1", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2KeypairDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.ComputeV2Client(osRegionName)
@@ -66,7 +116,14 @@ func testAccCheckComputeV2KeypairDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := keypairs.Get(computeClient, rs.Primary.ID).Extract()
+		userID := rs.Primary.Attributes["user_id"]
+		computeClient.Microversion = computeKeyPairV2Microversion(rs.Primary.Attributes["key_type"], userID)
+
+		getOpts := keypairs.GetOpts{
+			UserID: userID,
+		}
+
+		_, err := keypairs.Get(computeClient, rs.Primary.ID, getOpts).Extract()
 		if err == nil {
 			return fmt.Errorf("Keypair still exists")
 		}
@@ -92,7 +149,7 @@ func testAccCheckComputeV2KeypairExists(n string, kp *keypairs.KeyPair) resource
 			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 		}
 
-		found, err := keypairs.Get(computeClient, rs.Primary.ID).Extract()
+		found, err := keypairs.Get(computeClient, rs.Primary.ID, nil).Extract()
 		if err != nil {
 			return err
 		}
@@ -119,3 +176,24 @@ resource "openstack_compute_keypair_v2" "kp_1" {
   name = "kp_1"
 }
 `
+
+const testAccComputeV2KeypairGenerateED25519 = `
+resource "openstack_compute_keypair_v2" "kp_1" {
+  name          = "kp_1"
+  key_type      = "ssh"
+  key_algorithm = "ed25519"
+}
+`
+
+const testAccComputeV2KeypairUserID = `
+resource "openstack_identity_user_v3" "user_1" {
+  name = "user_1"
+}
+
+resource "openstack_compute_keypair_v2" "kp_1" {
+  name          = "kp_1"
+  key_algorithm = "rsa"
+  key_size      = 2048
+  user_id       = "${openstack_identity_user_v3.user_1.id}"
+}
+`
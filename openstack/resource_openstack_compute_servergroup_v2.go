@@ -5,7 +5,9 @@ import (
 	"log"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceComputeServerGroupV2() *schema.Resource {
@@ -33,10 +35,41 @@ func resourceComputeServerGroupV2() *schema.Resource {
 			},
 
 			"policies": {
-				Type:     schema.TypeList,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"policy", "rules"},
+			},
+
+			"policy": {
+				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validation.StringInSlice([]string{
+					"affinity", antiAffinityPolicy, softAffinityPolicy, softAntiAffinityPolicy,
+				}, false),
+				ConflictsWith: []string{"policies"},
+			},
+
+			"rules": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"policies"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_server_per_host": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
 			},
 
 			"members": {
@@ -51,6 +84,13 @@ func resourceComputeServerGroupV2() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Reject unsupported policy and rules combinations at plan time.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeServerGroupV2CustomizeDiff(diff)
+			},
+		),
 	}
 }
 
@@ -63,17 +103,40 @@ func resourceComputeServerGroupV2Create(d *schema.ResourceData, meta interface{}
 
 	name := d.Get("name").(string)
 
-	rawPolicies := d.Get("policies").([]interface{})
-	policies := expandComputeServerGroupV2Policies(computeClient, rawPolicies)
-
 	createOpts := ComputeServerGroupV2CreateOpts{
 		servergroups.CreateOpts{
-			Name:     name,
-			Policies: policies,
+			Name: name,
 		},
 		MapValueSpecs(d),
 	}
 
+	rawPolicies := d.Get("policies").([]interface{})
+	policy := d.Get("policy").(string)
+	rules := expandComputeServerGroupV2Rules(d.Get("rules").([]interface{}))
+
+	if policy != "" {
+		supported, err := computeV2MicroversionSupported(config, computeClient, computeServerGroupV2PolicyRulesMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+		}
+
+		switch {
+		case supported:
+			computeClient.Microversion = computeServerGroupV2PolicyRulesMicroversion
+			createOpts.Policy = policy
+			createOpts.Rules = rules
+		case rules != nil:
+			return fmt.Errorf("Error creating openstack_compute_servergroup_v2 %s: rules require Compute microversion %s", name, computeServerGroupV2PolicyRulesMicroversion)
+		default:
+			// Fall back to the policies list on older Compute APIs.
+			rawPolicies = []interface{}{policy}
+		}
+	}
+
+	if createOpts.Policy == "" {
+		createOpts.Policies = expandComputeServerGroupV2Policies(computeClient, rawPolicies)
+	}
+
 	log.Printf("[DEBUG] openstack_compute_servergroup_v2 create options: %#v", createOpts)
 	newSG, err := servergroups.Create(computeClient, createOpts).Extract()
 	if err != nil {
@@ -92,6 +155,15 @@ func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	supported, err := computeV2MicroversionSupported(config, computeClient, computeServerGroupV2PolicyRulesMicroversion)
+	if err != nil {
+		return fmt.Errorf("Error checking the microversion of the OpenStack compute API: %s", err)
+	}
+
+	if supported {
+		computeClient.Microversion = computeServerGroupV2PolicyRulesMicroversion
+	}
+
 	sg, err := servergroups.Get(computeClient, d.Id()).Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "Error retrieving openstack_compute_servergroup_v2")
@@ -99,10 +171,28 @@ func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Retrieved openstack_compute_servergroup_v2 %s: %#v", d.Id(), sg)
 
+	// Microversion 2.64 replaced the policies list with a single policy.
+	policies := sg.Policies
+	var policy string
+	if sg.Policy != nil {
+		policy = *sg.Policy
+	}
+	if len(policies) == 0 && policy != "" {
+		policies = []string{policy}
+	}
+	if policy == "" && len(policies) > 0 {
+		policy = policies[0]
+	}
+
 	d.Set("name", sg.Name)
-	d.Set("policies", sg.Policies)
+	d.Set("policies", policies)
+	d.Set("policy", policy)
 	d.Set("members", sg.Members)
 
+	if err := d.Set("rules", flattenComputeServerGroupV2Rules(sg.Rules)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_servergroup_v2 rules: %s", err)
+	}
+
 	d.Set("region", GetRegion(d, config))
 
 	return nil
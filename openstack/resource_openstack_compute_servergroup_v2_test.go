@@ -83,6 +83,33 @@ func TestAccComputeV2ServerGroup_soft_affinity(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2ServerGroup_rules(t *testing.T) {
+	var instance servers.Server
+	var sg servergroups.ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ServerGroupRules(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("openstack_compute_servergroup_v2.sg_1", &sg),
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceInServerGroup(&instance, &sg),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "policy", "anti-affinity"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "policies.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "rules.0.max_server_per_host", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2ServerGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.ComputeV2Client(osRegionName)
@@ -196,3 +223,26 @@ resource "openstack_compute_instance_v2" "instance_1" {
 }
 `, osNetworkID)
 }
+
+func testAccComputeV2ServerGroupRules() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name   = "sg_1"
+  policy = "anti-affinity"
+  rules {
+    max_server_per_host = 2
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  scheduler_hints {
+    group = "${openstack_compute_servergroup_v2.sg_1.id}"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+`, osNetworkID)
+}
@@ -0,0 +1,235 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceComputeServiceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeServiceV2Create,
+		Read:   resourceComputeServiceV2Read,
+		Update: resourceComputeServiceV2Update,
+		Delete: resourceComputeServiceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeServiceV2Import,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Only a disabled service can have a disabled_reason.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return computeServiceV2CustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"binary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "nova-compute",
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(services.ServiceEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.ServiceEnabled), string(services.ServiceDisabled),
+				}, false),
+			},
+
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"forced_down": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeServiceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	computeClient.Microversion, err = computeServiceV2NegotiateMicroversion(config, computeClient)
+	if err != nil {
+		return err
+	}
+
+	host := d.Get("host").(string)
+	binary := d.Get("binary").(string)
+
+	// Services register themselves, so the service is only adopted.
+	service, err := computeServiceV2Get(computeClient, host, binary)
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_service_v2 %s/%s: %s", host, binary, err)
+	}
+
+	forcedDown := d.Get("forced_down").(bool)
+	updateOpts := computeServiceV2UpdateOpts{
+		Status:         services.ServiceStatus(d.Get("status").(string)),
+		DisabledReason: d.Get("disabled_reason").(string),
+		ForcedDown:     &forcedDown,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_service_v2 %s/%s update options: %#v", host, binary, updateOpts)
+
+	err = computeServiceV2UpdateService(computeClient, service, updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_compute_service_v2 %s/%s: %s", host, binary, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", host, binary))
+
+	return resourceComputeServiceV2Read(d, meta)
+}
+
+func resourceComputeServiceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	computeClient.Microversion, err = computeServiceV2NegotiateMicroversion(config, computeClient)
+	if err != nil {
+		return err
+	}
+
+	service, err := computeServiceV2Get(computeClient, d.Get("host").(string), d.Get("binary").(string))
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_compute_service_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_service_v2 %s: %#v", d.Id(), service)
+
+	d.Set("host", service.Host)
+	d.Set("binary", service.Binary)
+	d.Set("status", service.Status)
+	d.Set("disabled_reason", service.DisabledReason)
+	d.Set("forced_down", service.ForcedDown)
+	d.Set("service_id", service.ID)
+	d.Set("state", service.State)
+	d.Set("zone", service.Zone)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeServiceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	computeClient.Microversion, err = computeServiceV2NegotiateMicroversion(config, computeClient)
+	if err != nil {
+		return err
+	}
+
+	var updateOpts computeServiceV2UpdateOpts
+
+	// The disabled_reason is only sent together with the status.
+	if d.HasChange("status") || d.HasChange("disabled_reason") {
+		updateOpts.Status = services.ServiceStatus(d.Get("status").(string))
+		updateOpts.DisabledReason = d.Get("disabled_reason").(string)
+	}
+
+	if d.HasChange("forced_down") {
+		forcedDown := d.Get("forced_down").(bool)
+		updateOpts.ForcedDown = &forcedDown
+	}
+
+	log.Printf("[DEBUG] openstack_compute_service_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	err = computeServiceV2UpdateService(computeClient, computeServiceV2FromState(d), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_compute_service_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceComputeServiceV2Read(d, meta)
+}
+
+func resourceComputeServiceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	computeClient.Microversion, err = computeServiceV2NegotiateMicroversion(config, computeClient)
+	if err != nil {
+		return err
+	}
+
+	// Deleting a service would unregister its host, so the service is only
+	// enabled again.
+	forcedDown := false
+	updateOpts := computeServiceV2UpdateOpts{
+		Status:     services.ServiceEnabled,
+		ForcedDown: &forcedDown,
+	}
+
+	err = computeServiceV2UpdateService(computeClient, computeServiceV2FromState(d), updateOpts)
+	if err != nil {
+		return CheckDeleted(d, err, "Error enabling openstack_compute_service_v2")
+	}
+
+	return nil
+}
+
+func resourceComputeServiceV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for openstack_compute_service_v2. Format must be <host>/<binary>")
+	}
+
+	d.Set("host", parts[0])
+	d.Set("binary", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccComputeV2Service_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckHypervisor(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ServiceBasic("disabled", "maintenance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_compute_service_v2.service_1", "status", "disabled"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_service_v2.service_1", "disabled_reason", "maintenance"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_service_v2.service_1", "service_id"),
+				),
+			},
+			{
+				Config: testAccComputeV2ServiceBasic("enabled", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_compute_service_v2.service_1", "status", "enabled"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_service_v2.service_1", "disabled_reason", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2ServiceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.ComputeV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_service_v2" {
+			continue
+		}
+
+		service, err := computeServiceV2Get(computeClient, rs.Primary.Attributes["host"], rs.Primary.Attributes["binary"])
+		if err != nil {
+			return err
+		}
+
+		if service.Status != string(services.ServiceEnabled) {
+			return fmt.Errorf("Service %s was not enabled again", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeV2ServiceBasic(status, reason string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_service_v2" "service_1" {
+  host            = "%s"
+  status          = "%s"
+  disabled_reason = "%s"
+}
+`, osHypervisorEnvironment, status, reason)
+}
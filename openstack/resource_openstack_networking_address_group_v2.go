@@ -0,0 +1,178 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingAddressGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingAddressGroupV2Create,
+		Read:   resourceNetworkingAddressGroupV2Read,
+		Update: resourceNetworkingAddressGroupV2Update,
+		Delete: resourceNetworkingAddressGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkingAddressGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingAddressGroupV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProjectID:   d.Get("project_id").(string),
+		Addresses:   expandToStringSlice(d.Get("addresses").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_address_group_v2 create options: %#v", createOpts)
+
+	g, err := networkingAddressGroupV2Create(networkingClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_networking_address_group_v2: %s", err)
+	}
+
+	d.SetId(g.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_address_group_v2 %s: %#v", g.ID, g)
+
+	return resourceNetworkingAddressGroupV2Read(d, meta)
+}
+
+func resourceNetworkingAddressGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	g, err := networkingAddressGroupV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_networking_address_group_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_address_group_v2 %s: %#v", d.Id(), g)
+
+	d.Set("name", g.Name)
+	d.Set("description", g.Description)
+	d.Set("project_id", g.ProjectID)
+	d.Set("addresses", g.Addresses)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingAddressGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		var updateOpts networkingAddressGroupV2UpdateOpts
+
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+
+		log.Printf("[DEBUG] openstack_networking_address_group_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = networkingAddressGroupV2Update(networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_networking_address_group_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("addresses") {
+		o, n := d.GetChange("addresses")
+		oldAddresses, newAddresses := o.(*schema.Set), n.(*schema.Set)
+		addressesToRemove := oldAddresses.Difference(newAddresses)
+		addressesToAdd := newAddresses.Difference(oldAddresses)
+
+		if addressesToRemove.Len() > 0 {
+			addresses := expandToStringSlice(addressesToRemove.List())
+			log.Printf("[DEBUG] Removing addresses %s from openstack_networking_address_group_v2 %s", addresses, d.Id())
+			err = networkingAddressGroupV2RemoveAddresses(networkingClient, d.Id(), addresses)
+			if err != nil {
+				return fmt.Errorf("Error removing addresses from openstack_networking_address_group_v2 %s: %s", d.Id(), err)
+			}
+		}
+
+		if addressesToAdd.Len() > 0 {
+			addresses := expandToStringSlice(addressesToAdd.List())
+			log.Printf("[DEBUG] Adding addresses %s to openstack_networking_address_group_v2 %s", addresses, d.Id())
+			err = networkingAddressGroupV2AddAddresses(networkingClient, d.Id(), addresses)
+			if err != nil {
+				return fmt.Errorf("Error adding addresses to openstack_networking_address_group_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceNetworkingAddressGroupV2Read(d, meta)
+}
+
+func resourceNetworkingAddressGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingAddressGroupV2Delete(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_networking_address_group_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccNetworkingV2AddressGroup_basic(t *testing.T) {
+	var addressGroup networkingAddressGroupV2
+	var secgroupRule rules.SecGroupRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AddressGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2AddressGroupBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AddressGroupExists("openstack_networking_address_group_v2.group_1", &addressGroup),
+					testAccCheckNetworkingV2SecGroupRuleExists(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", &secgroupRule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "name", "group_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "addresses.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", "remote_address_group_id",
+						"openstack_networking_address_group_v2.group_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2AddressGroupUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AddressGroupExists("openstack_networking_address_group_v2.group_1", &addressGroup),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "name", "group_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "description", "partner networks"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "addresses.#", "2"),
+					testAccCheckNetworkingV2AddressGroupAddresses(&addressGroup, "198.51.100.0/24", "2001:db8::/64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2AddressGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_address_group_v2" {
+			continue
+		}
+
+		_, err := networkingAddressGroupV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Address group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2AddressGroupExists(n string, addressGroup *networkingAddressGroupV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingAddressGroupV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Address group not found")
+		}
+
+		*addressGroup = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2AddressGroupAddresses(addressGroup *networkingAddressGroupV2, addresses ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(addressGroup.Addresses) != len(addresses) {
+			return fmt.Errorf("Expected %d addresses, got %d", len(addresses), len(addressGroup.Addresses))
+		}
+
+		for _, address := range addresses {
+			if !strSliceContains(addressGroup.Addresses, address) {
+				return fmt.Errorf("Address %s not found in address group %s", address, addressGroup.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2AddressGroupBasic = `
+resource "openstack_networking_address_group_v2" "group_1" {
+  name = "group_1"
+  addresses = [
+    "192.0.2.0/24",
+    "2001:db8::/64",
+  ]
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rule acceptance test"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "secgroup_rule_1" {
+  direction = "ingress"
+  ethertype = "IPv4"
+  port_range_max = 22
+  port_range_min = 22
+  protocol = "tcp"
+  remote_address_group_id = "${openstack_networking_address_group_v2.group_1.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.secgroup_1.id}"
+}
+`
+
+const testAccNetworkingV2AddressGroupUpdate = `
+resource "openstack_networking_address_group_v2" "group_1" {
+  name = "group_1_updated"
+  description = "partner networks"
+  addresses = [
+    "198.51.100.0/24",
+    "2001:db8::/64",
+  ]
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rule acceptance test"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "secgroup_rule_1" {
+  direction = "ingress"
+  ethertype = "IPv4"
+  port_range_max = 22
+  port_range_min = 22
+  protocol = "tcp"
+  remote_address_group_id = "${openstack_networking_address_group_v2.group_1.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.secgroup_1.id}"
+}
+`
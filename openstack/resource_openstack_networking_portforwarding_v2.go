@@ -0,0 +1,204 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingPortForwardingV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingPortForwardingV2Create,
+		Read:   resourceNetworkingPortForwardingV2Read,
+		Update: resourceNetworkingPortForwardingV2Update,
+		Delete: resourceNetworkingPortForwardingV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkingPortForwardingV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"internal_port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"internal_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"internal_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"external_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingPortForwardingV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	fipID := d.Get("floatingip_id").(string)
+	createOpts := networkingPortForwardingV2CreateOpts{
+		CreateOpts: portforwarding.CreateOpts{
+			InternalPortID:    d.Get("internal_port_id").(string),
+			InternalIPAddress: d.Get("internal_ip_address").(string),
+			InternalPort:      d.Get("internal_port").(int),
+			ExternalPort:      d.Get("external_port").(int),
+			Protocol:          d.Get("protocol").(string),
+		},
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_portforwarding_v2 create options: %#v", createOpts)
+
+	pf, err := portforwarding.Create(networkingClient, fipID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_networking_portforwarding_v2 on floating IP %s: %s", fipID, err)
+	}
+
+	d.SetId(pf.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_portforwarding_v2 %s: %#v", pf.ID, pf)
+
+	return resourceNetworkingPortForwardingV2Read(d, meta)
+}
+
+func resourceNetworkingPortForwardingV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var pf networkingPortForwardingV2
+	err = portforwarding.Get(networkingClient, d.Get("floatingip_id").(string), d.Id()).ExtractInto(&pf)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_networking_portforwarding_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_portforwarding_v2 %s: %#v", d.Id(), pf)
+
+	d.Set("internal_port_id", pf.InternalPortID)
+	d.Set("internal_ip_address", pf.InternalIPAddress)
+	d.Set("internal_port", pf.InternalPort)
+	d.Set("external_port", pf.ExternalPort)
+	d.Set("protocol", pf.Protocol)
+	d.Set("description", pf.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingPortForwardingV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts networkingPortForwardingV2UpdateOpts
+
+	if d.HasChange("internal_port_id") {
+		updateOpts.InternalPortID = d.Get("internal_port_id").(string)
+	}
+
+	if d.HasChange("internal_ip_address") {
+		updateOpts.InternalIPAddress = d.Get("internal_ip_address").(string)
+	}
+
+	if d.HasChange("internal_port") {
+		updateOpts.InternalPort = d.Get("internal_port").(int)
+	}
+
+	if d.HasChange("external_port") {
+		updateOpts.ExternalPort = d.Get("external_port").(int)
+	}
+
+	if d.HasChange("protocol") {
+		updateOpts.Protocol = d.Get("protocol").(string)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] openstack_networking_portforwarding_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = portforwarding.Update(networkingClient, d.Get("floatingip_id").(string), d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_networking_portforwarding_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingPortForwardingV2Read(d, meta)
+}
+
+func resourceNetworkingPortForwardingV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = portforwarding.Delete(networkingClient, d.Get("floatingip_id").(string), d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_networking_portforwarding_v2")
+	}
+
+	return nil
+}
+
+func resourceNetworkingPortForwardingV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for openstack_networking_portforwarding_v2. Format must be <floatingip id>/<portforwarding id>")
+	}
+
+	d.SetId(parts[1])
+	d.Set("floatingip_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccNetworkingV2PortForwarding_basic(t *testing.T) {
+	var pf portforwarding.PortForwarding
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortForwardingBasic(2222, "ssh"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortForwardingExists("openstack_networking_portforwarding_v2.pf_1", &pf),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_portforwarding_v2.pf_1", "external_port", "2222"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_portforwarding_v2.pf_1", "description", "ssh"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortForwardingBasic(2223, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortForwardingExists("openstack_networking_portforwarding_v2.pf_1", &pf),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_portforwarding_v2.pf_1", "external_port", "2223"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_portforwarding_v2.pf_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2PortForwardingDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_portforwarding_v2" {
+			continue
+		}
+
+		_, err := portforwarding.Get(networkingClient, rs.Primary.Attributes["floatingip_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Port forwarding still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2PortForwardingExists(n string, pf *portforwarding.PortForwarding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := portforwarding.Get(networkingClient, rs.Primary.Attributes["floatingip_id"], rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Port forwarding not found")
+		}
+
+		*pf = *found
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2PortForwardingBasic(externalPort int, description string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  admin_state_up = "true"
+  network_id = "${openstack_networking_subnet_v2.subnet_1.network_id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.10"
+  }
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_networking_portforwarding_v2" "pf_1" {
+  floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+  internal_port_id = "${openstack_networking_port_v2.port_1.id}"
+  internal_ip_address = "192.168.199.10"
+  internal_port = 22
+  external_port = %d
+  protocol = "tcp"
+  description = "%s"
+
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, osExtGwID, osPoolName, externalPort, description)
+}
@@ -80,6 +80,14 @@ func resourceNetworkingSecGroupRuleV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"remote_address_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"remote_group_id", "remote_ip_prefix"},
+			},
+
 			"remote_ip_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -128,14 +136,17 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	opts := rules.CreateOpts{
-		Description:    d.Get("description").(string),
-		SecGroupID:     d.Get("security_group_id").(string),
-		PortRangeMin:   d.Get("port_range_min").(int),
-		PortRangeMax:   d.Get("port_range_max").(int),
-		RemoteGroupID:  d.Get("remote_group_id").(string),
-		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
-		ProjectID:      d.Get("tenant_id").(string),
+	opts := networkingSecGroupRuleV2CreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Description:    d.Get("description").(string),
+			SecGroupID:     d.Get("security_group_id").(string),
+			PortRangeMin:   d.Get("port_range_min").(int),
+			PortRangeMax:   d.Get("port_range_max").(int),
+			RemoteGroupID:  d.Get("remote_group_id").(string),
+			RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+			ProjectID:      d.Get("tenant_id").(string),
+		},
+		RemoteAddressGroupID: d.Get("remote_address_group_id").(string),
 	}
 
 	if v, ok := d.GetOk("direction"); ok {
@@ -182,7 +193,8 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	sgRule, err := rules.Get(networkingClient, d.Id()).Extract()
+	var sgRule networkingSecGroupRuleV2
+	err = rules.Get(networkingClient, d.Id()).ExtractIntoStructPtr(&sgRule, "security_group_rule")
 	if err != nil {
 		return CheckDeleted(d, err, "Error getting openstack_networking_secgroup_rule_v2")
 	}
@@ -196,6 +208,7 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 	d.Set("port_range_min", sgRule.PortRangeMin)
 	d.Set("port_range_max", sgRule.PortRangeMax)
 	d.Set("remote_group_id", sgRule.RemoteGroupID)
+	d.Set("remote_address_group_id", sgRule.RemoteAddressGroupID)
 	d.Set("remote_ip_prefix", sgRule.RemoteIPPrefix)
 	d.Set("security_group_id", sgRule.SecGroupID)
 	d.Set("tenant_id", sgRule.TenantID)
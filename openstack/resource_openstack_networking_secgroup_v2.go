@@ -3,10 +3,13 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
@@ -70,7 +73,89 @@ func resourceNetworkingSecGroupV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      networkingSecGroupV2RuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(rules.DirIngress), string(rules.DirEgress),
+							}, false),
+						},
+
+						"ethertype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(rules.EtherType4), string(rules.EtherType6),
+							}, false),
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"port_range_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"port_range_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(v.(string))
+							},
+						},
+
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"remote_address_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"self": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Reject invalid inline rules at plan time.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return networkingSecGroupV2RulesCheckForErrors(diff.Get("rule").(*schema.Set).List())
+			},
+		),
 	}
 }
 
@@ -111,6 +196,15 @@ func resourceNetworkingSecGroupV2Create(d *schema.ResourceData, meta interface{}
 
 	d.SetId(sg.ID)
 
+	// Inline rules are authoritative, so any default rule, which isn't
+	// configured, is removed.
+	if v, ok := d.GetOk("rule"); ok {
+		err = networkingSecGroupV2CreateRules(networkingClient, config, sg.ID, v.(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+
 	tags := networkingV2AttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -133,11 +227,17 @@ func resourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	sg, err := groups.Get(networkingClient, d.Id()).Extract()
+	r := groups.Get(networkingClient, d.Id())
+	sg, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "Error retrieving openstack_networking_secgroup_v2")
 	}
 
+	sgRules, err := networkingSecGroupV2ExtractRules(r)
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_networking_secgroup_v2 %s rules: %s", d.Id(), err)
+	}
+
 	d.Set("description", sg.Description)
 	d.Set("tenant_id", sg.TenantID)
 	d.Set("name", sg.Name)
@@ -145,6 +245,12 @@ func resourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{})
 
 	networkingV2ReadAttributesTags(d, sg.Tags)
 
+	// All rules are reported, so rules created outside of Terraform show up
+	// as a difference, when inline rules are configured.
+	if err := d.Set("rule", flattenNetworkingSecGroupV2Rules(sg.ID, sgRules)); err != nil {
+		return fmt.Errorf("Unable to set openstack_networking_secgroup_v2 %s rules: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -179,6 +285,17 @@ func resourceNetworkingSecGroupV2Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("rule") {
+		oldRulesRaw, newRulesRaw := d.GetChange("rule")
+
+		config.MutexKV.Lock(d.Id())
+		err = networkingSecGroupV2UpdateRules(networkingClient, d.Id(), oldRulesRaw.(*schema.Set), newRulesRaw.(*schema.Set))
+		config.MutexKV.Unlock(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 )
 
 func TestAccNetworkingV2SecGroup_basic(t *testing.T) {
@@ -75,6 +76,102 @@ func TestAccNetworkingV2SecGroup_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroup_rules(t *testing.T) {
+	var securityGroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					// The default egress rules are removed.
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 3),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "3"),
+				),
+			},
+			{
+				// A rule created outside of Terraform is reported as drift.
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupAddRule(&securityGroup),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The next apply removes it again.
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroup_rulesAddressGroup(t *testing.T) {
+	var securityGroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRulesAddressGroup,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 1),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupAddRule(sg *groups.SecGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		opts := rules.CreateOpts{
+			Direction:      rules.DirIngress,
+			EtherType:      rules.EtherType4,
+			SecGroupID:     sg.ID,
+			Protocol:       rules.ProtocolTCP,
+			PortRangeMin:   8080,
+			PortRangeMax:   8080,
+			RemoteIPPrefix: "10.0.0.0/8",
+		}
+
+		_, err = rules.Create(networkingClient, opts).Extract()
+
+		return err
+	}
+}
+
 func testAccCheckNetworkingV2SecGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -171,3 +268,74 @@ resource "openstack_networking_secgroup_v2" "secgroup_1" {
   }
 }
 `
+
+const testAccNetworkingV2SecGroupRules = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "192.168.0.0/16"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    self = true
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesUpdate = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    self = true
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv6"
+    description = "all outgoing IPv6"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesAddressGroup = `
+resource "openstack_networking_address_group_v2" "group_1" {
+  name      = "group_1"
+  addresses = ["192.168.0.0/24"]
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_address_group_id = "${openstack_networking_address_group_v2.group_1.id}"
+  }
+}
+`
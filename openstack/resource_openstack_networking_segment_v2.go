@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingSegmentV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSegmentV2Create,
+		Read:   resourceNetworkingSegmentV2Read,
+		Update: resourceNetworkingSegmentV2Update,
+		Delete: resourceNetworkingSegmentV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"physical_network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"segmentation_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSegmentV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingSegmentV2CreateOpts{
+		NetworkID:       d.Get("network_id").(string),
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		NetworkType:     d.Get("network_type").(string),
+		PhysicalNetwork: d.Get("physical_network").(string),
+		SegmentationID:  d.Get("segmentation_id").(int),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_segment_v2 create options: %#v", createOpts)
+
+	s, err := networkingSegmentV2Create(networkingClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_networking_segment_v2: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_segment_v2 %s: %#v", s.ID, s)
+
+	return resourceNetworkingSegmentV2Read(d, meta)
+}
+
+func resourceNetworkingSegmentV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	s, err := networkingSegmentV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_networking_segment_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_segment_v2 %s: %#v", d.Id(), s)
+
+	d.Set("network_id", s.NetworkID)
+	d.Set("network_type", s.NetworkType)
+	d.Set("physical_network", s.PhysicalNetwork)
+	d.Set("segmentation_id", s.SegmentationID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSegmentV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts networkingSegmentV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] openstack_networking_segment_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	err = networkingSegmentV2Update(networkingClient, d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_networking_segment_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingSegmentV2Read(d, meta)
+}
+
+func resourceNetworkingSegmentV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingSegmentV2Delete(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_networking_segment_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccNetworkingV2Segment_basic(t *testing.T) {
+	var segment networkingSegmentV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SegmentBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SegmentExists("openstack_networking_segment_v2.segment_1", &segment),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_v2.segment_1", "name", "segment_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_v2.segment_1", "network_type", "vxlan"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_v2.segment_1", "segmentation_id", "2016"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_subnet_v2.subnet_1", "segment_id",
+						"openstack_networking_segment_v2.segment_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SegmentUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SegmentExists("openstack_networking_segment_v2.segment_1", &segment),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_v2.segment_1", "name", "segment_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_v2.segment_1", "description", "rack 1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SegmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_segment_v2" {
+			continue
+		}
+
+		_, err := networkingSegmentV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Segment still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2SegmentExists(n string, segment *networkingSegmentV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingSegmentV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Segment not found")
+		}
+
+		*segment = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2SegmentBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_segment_v2" "segment_1" {
+  name = "segment_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  network_type = "vxlan"
+  segmentation_id = 2016
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  segment_id = "${openstack_networking_segment_v2.segment_1.id}"
+}
+`
+
+const testAccNetworkingV2SegmentUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_segment_v2" "segment_1" {
+  name = "segment_1_updated"
+  description = "rack 1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  network_type = "vxlan"
+  segmentation_id = 2016
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  segment_id = "${openstack_networking_segment_v2.segment_1.id}"
+}
+`
@@ -200,6 +200,12 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"segment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"value_specs": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -224,6 +230,14 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 			func(diff *schema.ResourceDiff, v interface{}) error {
 				return networkingSubnetV2AllocationPoolsCustomizeDiff(diff)
 			},
+			// Reject invalid combinations of the IPv6 modes at plan time.
+			func(diff *schema.ResourceDiff, v interface{}) error {
+				return networkingSubnetV2IPv6ModesCustomizeDiff(diff)
+			},
+			// A segment can only be set on a subnet without one.
+			customdiff.ForceNewIfChange("segment_id", func(old, new, meta interface{}) bool {
+				return old.(string) != ""
+			}),
 		),
 	}
 }
@@ -259,6 +273,7 @@ func resourceNetworkingSubnetV2Create(d *schema.ResourceData, meta interface{})
 			IPVersion:       gophercloud.IPVersion(d.Get("ip_version").(int)),
 		},
 		MapValueSpecs(d),
+		d.Get("segment_id").(string),
 	}
 
 	// Set CIDR if provided.
@@ -335,7 +350,8 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	s, err := subnets.Get(networkingClient, d.Id()).Extract()
+	var s networkingSubnetV2
+	err = subnets.Get(networkingClient, d.Id()).ExtractIntoStructPtr(&s, "subnet")
 	if err != nil {
 		return CheckDeleted(d, err, "Error getting openstack_networking_subnet_v2")
 	}
@@ -354,6 +370,7 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("ipv6_address_mode", s.IPv6AddressMode)
 	d.Set("ipv6_ra_mode", s.IPv6RAMode)
 	d.Set("subnetpool_id", s.SubnetPoolID)
+	d.Set("segment_id", s.SegmentID)
 
 	networkingV2ReadAttributesTags(d, s.Tags)
 
@@ -388,7 +405,7 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 	}
 
 	var hasChange bool
-	var updateOpts subnets.UpdateOpts
+	var updateOpts networkingSubnetV2UpdateOpts
 
 	if d.HasChange("name") {
 		hasChange = true
@@ -440,6 +457,12 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 		updateOpts.EnableDHCP = &v
 	}
 
+	if d.HasChange("segment_id") {
+		hasChange = true
+		segmentID := d.Get("segment_id").(string)
+		updateOpts.SegmentID = &segmentID
+	}
+
 	if d.HasChange("allocation_pool") {
 		hasChange = true
 		updateOpts.AllocationPools = expandNetworkingSubnetV2AllocationPools(d.Get("allocation_pool").(*schema.Set).List())
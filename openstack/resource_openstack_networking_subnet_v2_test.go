@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -321,6 +322,41 @@ func testAccCheckNetworkingV2SubnetDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestAccNetworkingV2Subnet_ipv6Modes(t *testing.T) {
+	var subnet subnets.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkingV2SubnetIPv6Modes("slaac", "dhcpv6-stateful"),
+				ExpectError: regexp.MustCompile("must be equal"),
+			},
+			{
+				Config: testAccNetworkingV2SubnetIPv6Modes("slaac", "slaac"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "ipv6_address_mode", "slaac"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "ipv6_ra_mode", "slaac"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SubnetIPv6Modes("dhcpv6-stateless", "dhcpv6-stateless"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "ipv6_address_mode", "dhcpv6-stateless"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "ipv6_ra_mode", "dhcpv6-stateless"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SubnetExists(n string, subnet *subnets.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -710,3 +746,21 @@ resource "openstack_networking_subnet_v2" "subnet_1" {
   }
 }
 `
+
+func testAccNetworkingV2SubnetIPv6Modes(addressMode, raMode string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "fd00:0:0:1::/64"
+  ip_version = 6
+  ipv6_address_mode = "%s"
+  ipv6_ra_mode = "%s"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+`, addressMode, raMode)
+}
@@ -212,12 +212,31 @@ func resourceObjectStorageContainerV1Update(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("error creating OpenStack object storage client: %s", err)
 	}
 
-	updateOpts := containers.UpdateOpts{
-		ContainerRead:    d.Get("container_read").(string),
-		ContainerSyncTo:  d.Get("container_sync_to").(string),
-		ContainerSyncKey: d.Get("container_sync_key").(string),
-		ContainerWrite:   d.Get("container_write").(string),
-		ContentType:      d.Get("content_type").(string),
+	var updateOpts containers.UpdateOpts
+
+	if d.HasChange("container_read") {
+		containerRead := d.Get("container_read").(string)
+		updateOpts.ContainerRead = &containerRead
+	}
+
+	if d.HasChange("container_sync_to") {
+		containerSyncTo := d.Get("container_sync_to").(string)
+		updateOpts.ContainerSyncTo = &containerSyncTo
+	}
+
+	if d.HasChange("container_sync_key") {
+		containerSyncKey := d.Get("container_sync_key").(string)
+		updateOpts.ContainerSyncKey = &containerSyncKey
+	}
+
+	if d.HasChange("container_write") {
+		containerWrite := d.Get("container_write").(string)
+		updateOpts.ContainerWrite = &containerWrite
+	}
+
+	if d.HasChange("content_type") {
+		contentType := d.Get("content_type").(string)
+		updateOpts.ContentType = &contentType
 	}
 
 	if d.HasChange("versioning") {
@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
@@ -78,10 +79,11 @@ func (opts RouterCreateOpts) ToRouterCreateMap() (map[string]interface{}, error)
 type SubnetCreateOpts struct {
 	subnets.CreateOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	SegmentID  string            `json:"segment_id,omitempty"`
 }
 
 // ToSubnetCreateMap casts a CreateOpts struct to a map.
-// It overrides subnets.ToSubnetCreateMap to add the ValueSpecs field.
+// It overrides subnets.ToSubnetCreateMap to add the ValueSpecs and SegmentID fields.
 func (opts SubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
 	b, err := BuildRequest(opts, "subnet")
 	if err != nil {
@@ -124,3 +126,18 @@ type SiteConnectionCreateOpts struct {
 	siteconnections.CreateOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// ServerRebuildOpts represents the attributes used when rebuilding a server.
+type ServerRebuildOpts struct {
+	servers.RebuildOpts
+	KeyName           string `json:"key_name,omitempty"`
+	UserData          string `json:"user_data,omitempty"`
+	PreserveEphemeral *bool  `json:"preserve_ephemeral,omitempty"`
+}
+
+// ToServerRebuildMap casts a RebuildOpts struct to a map.
+// It overrides servers.ToServerRebuildMap to add the KeyName, UserData and
+// PreserveEphemeral fields.
+func (opts ServerRebuildOpts) ToServerRebuildMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "rebuild")
+}